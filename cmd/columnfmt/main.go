@@ -0,0 +1,173 @@
+// Command columnfmt aligns the closing pipes of StripColumn raw string literals in Go files.
+//
+// Every literal is aligned unless -ragged is given, which only changes literals whose rows are mostly aligned, like
+// margincheck reports them, and leaves ragged literals such as rendered output alone. Rows are aligned by padding before the opening pipe and whitespace after closing pipes is removed, so the
+// stripped content never changes. Like gofmt, it formats standard input when no paths are given and walks
+// directories for .go files, skipping testdata directories.
+//
+// Usage:
+//
+//	columnfmt [-l] [-w] [-d] [-ragged] [path ...]
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/shapestone/textsmith/internal/columnfmt"
+)
+
+var (
+	list   = flag.Bool("l", false, "list files whose formatting differs from columnfmt's")
+	write  = flag.Bool("w", false, "write result to (source) file instead of stdout")
+	diff   = flag.Bool("d", false, "display diffs instead of rewriting files")
+	ragged = flag.Bool("ragged", false, "leave ragged literals unchanged, only aligning those margincheck reports")
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: columnfmt [flags] [path ...]\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		if *write {
+			fmt.Fprintln(os.Stderr, "columnfmt: cannot use -w with standard input")
+			os.Exit(2)
+		}
+		if err := processFile("<standard input>", os.Stdin, os.Stdout); err != nil {
+			fmt.Fprintf(os.Stderr, "columnfmt: %v\n", err)
+			os.Exit(2)
+		}
+		return
+	}
+
+	failed := false
+	for _, path := range flag.Args() {
+		err := filepath.WalkDir(path, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() && d.Name() == "testdata" {
+				// Test fixtures may be malformed on purpose, so walks skip them like the go tool does
+				return filepath.SkipDir
+			}
+			if d.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasPrefix(d.Name(), ".") {
+				return nil
+			}
+			if err := processFile(path, nil, os.Stdout); err != nil {
+				fmt.Fprintf(os.Stderr, "columnfmt: %v\n", err)
+				failed = true
+			}
+			return nil
+		})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "columnfmt: %v\n", err)
+			failed = true
+		}
+	}
+	if failed {
+		os.Exit(2)
+	}
+}
+
+// processFile aligns a single file, read from in when it is not nil, and reports or writes the result
+func processFile(filename string, in io.Reader, out io.Writer) error {
+	var src []byte
+	var err error
+	if in != nil {
+		src, err = io.ReadAll(in)
+	} else {
+		src, err = os.ReadFile(filename)
+	}
+	if err != nil {
+		return err
+	}
+
+	res, err := columnfmt.SourceWithOptions(filename, src, columnfmt.Options{SkipRagged: *ragged})
+	if err != nil {
+		return err
+	}
+
+	if bytes.Equal(src, res) {
+		if !*list && !*write && !*diff {
+			_, err = out.Write(res)
+		}
+		return err
+	}
+	if *list {
+		fmt.Fprintln(out, filename)
+	}
+	if *write {
+		info, err := os.Stat(filename)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(filename, res, info.Mode().Perm()); err != nil {
+			return err
+		}
+	}
+	if *diff {
+		fmt.Fprint(out, unifiedDiff(filename, string(src), string(res)))
+	}
+	if !*list && !*write && !*diff {
+		_, err = out.Write(res)
+	}
+	return err
+}
+
+// unifiedDiff returns a unified diff of two versions of a file. Alignment never adds or removes lines, so lines
+// are compared pairwise.
+func unifiedDiff(filename, a, b string) string {
+	const context = 3
+	aLines := strings.Split(a, "\n")
+	bLines := strings.Split(b, "\n")
+
+	var changed []int
+	for i := range aLines {
+		if aLines[i] != bLines[i] {
+			changed = append(changed, i)
+		}
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "diff -u %s.orig %s\n--- %s.orig\n+++ %s\n", filename, filename, filename, filename)
+	for len(changed) > 0 {
+		// A hunk spans changes separated by at most twice the context
+		n := 1
+		for n < len(changed) && changed[n]-changed[n-1] <= 2*context {
+			n++
+		}
+		start := max(0, changed[0]-context)
+		stop := min(len(aLines), changed[n-1]+context+1)
+		changed = changed[n:]
+
+		fmt.Fprintf(&sb, "@@ -%d,%d +%d,%d @@\n", start+1, stop-start, start+1, stop-start)
+		for i := start; i < stop; {
+			if aLines[i] == bLines[i] {
+				sb.WriteString(" " + aLines[i] + "\n")
+				i++
+				continue
+			}
+			end := i
+			for end < stop && aLines[end] != bLines[end] {
+				end++
+			}
+			for _, line := range aLines[i:end] {
+				sb.WriteString("-" + line + "\n")
+			}
+			for _, line := range bLines[i:end] {
+				sb.WriteString("+" + line + "\n")
+			}
+			i = end
+		}
+	}
+	return sb.String()
+}
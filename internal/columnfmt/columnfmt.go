@@ -0,0 +1,121 @@
+// Package columnfmt aligns the closing pipes of StripColumn literals.
+//
+// Whitespace before the closing pipe is content in StripColumn, so rows are aligned by adding spaces before the
+// opening pipe, which StripColumn strips. Whitespace after the closing pipe is ignored by StripColumn and removed.
+// The stripped content of a literal never changes.
+package columnfmt
+
+import (
+	"strings"
+
+	"github.com/shapestone/textsmith/pkg/text"
+)
+
+// TabWidth is the tab width used to measure the indentation of literal rows
+const TabWidth = 4
+
+// Row describes a well-formed |content| line of a literal body. Offsets are byte offsets into the body.
+type Row struct {
+	// Line is the 0-based line index in the body
+	Line int
+	// Pipe is the offset of the opening pipe
+	Pipe int
+	// End is the offset just after the closing pipe
+	End int
+	// LineEnd is the offset of the end of the line, before any \r
+	LineEnd int
+	// Pad is the number of spaces to insert before the opening pipe to align the closing pipe by display width
+	Pad int
+}
+
+// Rows returns every |content| row of a literal body, with Pad set relative to the rightmost closing pipe
+func Rows(body string) []Row {
+	var rows []Row
+	var columns []int
+	target := 0
+
+	start := 0
+	for i, line := range strings.Split(body, "\n") {
+		if row, column, ok := parseRow(line); ok {
+			row.Line = i
+			row.Pipe += start
+			row.End += start
+			row.LineEnd += start
+			rows = append(rows, row)
+			columns = append(columns, column)
+			target = max(target, column)
+		}
+		start += len(line) + 1
+	}
+
+	for i := range rows {
+		rows[i].Pad = target - columns[i]
+	}
+	return rows
+}
+
+// Misaligned reports whether rows look meant to be aligned but are not: most of them, and at least two, close at
+// the rightmost column while others close left of it. Literals with ragged rows, such as rendered output, are not
+// considered misaligned.
+func Misaligned(rows []Row) bool {
+	aligned := 0
+	for _, row := range rows {
+		if row.Pad == 0 {
+			aligned++
+		}
+	}
+	return aligned >= 2 && aligned*2 > len(rows) && aligned < len(rows)
+}
+
+// Align returns the literal body with all closing pipes moved to the column of the rightmost closing pipe and
+// whitespace after closing pipes removed. Lines that are not |content| rows are left unchanged.
+func Align(body string) string {
+	var sb strings.Builder
+	sb.Grow(len(body))
+
+	last := 0
+	for _, row := range Rows(body) {
+		sb.WriteString(body[last:row.Pipe])
+		sb.WriteString(strings.Repeat(" ", row.Pad))
+		sb.WriteString(body[row.Pipe:row.End])
+		last = row.LineEnd
+	}
+	sb.WriteString(body[last:])
+	return sb.String()
+}
+
+// parseRow recognizes a |content| line and returns the display column of its closing pipe
+func parseRow(line string) (Row, int, bool) {
+	lineEnd := len(strings.TrimSuffix(line, "\r"))
+	pipe := 0
+	for pipe < lineEnd && (line[pipe] == ' ' || line[pipe] == '\t') {
+		pipe++
+	}
+	if pipe == lineEnd || line[pipe] != '|' {
+		return Row{}, 0, false
+	}
+
+	end := lineEnd
+	for end > pipe && (line[end-1] == ' ' || line[end-1] == '\t') {
+		end--
+	}
+	if end-1 <= pipe || line[end-1] != '|' {
+		return Row{}, 0, false
+	}
+
+	column := columns(line[:end-1], 0)
+	return Row{Pipe: pipe, End: end, LineEnd: lineEnd}, column, true
+}
+
+// columns returns the display column after writing s at column, expanding tabs to multiples of TabWidth
+func columns(s string, column int) int {
+	for {
+		i := strings.IndexByte(s, '\t')
+		if i < 0 {
+			return column + text.DisplayWidth(s)
+		}
+		column += text.DisplayWidth(s[:i])
+		column += TabWidth - column%TabWidth
+		s = s[i+1:]
+	}
+}
@@ -0,0 +1,68 @@
+package columnfmt_test
+
+import (
+	"testing"
+
+	"github.com/shapestone/textsmith/internal/columnfmt"
+	"github.com/shapestone/textsmith/pkg/text"
+)
+
+func TestAlign_WithShortRows_PadsBeforeOpeningPipe(t *testing.T) {
+	// Given
+	body := "\n\t|name |\n\t|a|  \n\t|email|\n\tnot a row\n"
+
+	// When
+	result := columnfmt.Align(body)
+
+	// Then
+	expected := "\n\t|name |\n\t    |a|\n\t|email|\n\tnot a row\n"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+	if text.StripColumn(result) != text.StripColumn(body) {
+		t.Fatalf("Expected stripped content to be unchanged, got %q", text.StripColumn(result))
+	}
+}
+
+func TestAlign_WithTabIndentation_MeasuresTabStops(t *testing.T) {
+	// Given
+	body := "\t|abc|\n    |abcd|\r\n"
+
+	// When
+	result := columnfmt.Align(body)
+
+	// Then
+	expected := "\t |abc|\n    |abcd|\r\n"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestMisaligned_WithRaggedRows_ReturnsFalse(t *testing.T) {
+	// Given
+	rows := columnfmt.Rows("|Summary: 2 changed|\n|a.txt|\n|b.txt|")
+
+	// When
+	result := columnfmt.Misaligned(rows)
+
+	// Then
+	if result {
+		t.Fatalf("Expected ragged rows not to be reported")
+	}
+}
+
+func TestMisaligned_WithOneShortRow_ReturnsTrue(t *testing.T) {
+	// Given
+	rows := columnfmt.Rows("|id  |\n|name|\n|x|")
+
+	// When
+	result := columnfmt.Misaligned(rows)
+
+	// Then
+	if !result {
+		t.Fatalf("Expected a short row in an aligned table to be reported")
+	}
+	if rows[2].Pad != 3 {
+		t.Fatalf("Expected pad 3, got %d", rows[2].Pad)
+	}
+}
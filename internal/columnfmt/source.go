@@ -0,0 +1,94 @@
+package columnfmt
+
+import (
+	"bytes"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+)
+
+const textPackage = "github.com/shapestone/textsmith/pkg/text"
+
+// Options configures SourceWithOptions.
+type Options struct {
+	// SkipRagged leaves literals unchanged unless Misaligned reports them, like margincheck does, so intentionally
+	// ragged literals such as rendered output are left alone
+	SkipRagged bool
+}
+
+// Source returns the Go source file with the closing pipes of every StripColumn raw string literal aligned. Files
+// that do not import pkg/text are returned unchanged. Calls are recognized by the import name of pkg/text, without
+// type checking, like gofmt.
+func Source(filename string, src []byte) ([]byte, error) {
+	return SourceWithOptions(filename, src, Options{})
+}
+
+// SourceWithOptions is like Source but can leave ragged literals unchanged.
+func SourceWithOptions(filename string, src []byte, opts Options) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+	names := importNames(file)
+	if len(names) == 0 {
+		return src, nil
+	}
+
+	var out bytes.Buffer
+	last := 0
+	ast.Inspect(file, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) != 1 || !isStripColumn(call.Fun, names) {
+			return true
+		}
+		lit, ok := call.Args[0].(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING || lit.Value[0] != '`' {
+			return true
+		}
+		// The literal value has carriage returns removed, so the body is read from the source
+		start := fset.Position(lit.Pos()).Offset + 1
+		end := fset.Position(lit.End()).Offset - 1
+		body := string(src[start:end])
+		if opts.SkipRagged && !Misaligned(Rows(body)) {
+			return true
+		}
+		out.Write(src[last:start])
+		out.WriteString(Align(body))
+		last = end
+		return true
+	})
+	out.Write(src[last:])
+	return out.Bytes(), nil
+}
+
+// importNames returns the names pkg/text is imported as, "." for a dot import
+func importNames(file *ast.File) map[string]bool {
+	names := map[string]bool{}
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil || path != textPackage {
+			continue
+		}
+		switch {
+		case spec.Name == nil:
+			names["text"] = true
+		case spec.Name.Name != "_":
+			names[spec.Name.Name] = true
+		}
+	}
+	return names
+}
+
+// isStripColumn reports whether fun refers to StripColumn of pkg/text imported under one of names
+func isStripColumn(fun ast.Expr, names map[string]bool) bool {
+	switch fun := fun.(type) {
+	case *ast.SelectorExpr:
+		x, ok := fun.X.(*ast.Ident)
+		return ok && names[x.Name] && fun.Sel.Name == "StripColumn"
+	case *ast.Ident:
+		return names["."] && fun.Name == "StripColumn"
+	}
+	return false
+}
@@ -0,0 +1,166 @@
+package columnfmt_test
+
+import (
+	"testing"
+
+	"github.com/shapestone/textsmith/internal/columnfmt"
+	"github.com/shapestone/textsmith/pkg/text"
+)
+
+func TestSource_WithStripColumnLiterals_AlignsOnlyThoseLiterals(t *testing.T) {
+	// Given
+	src := text.StripMargin(`
+		|package a
+		|
+		|import "github.com/shapestone/textsmith/pkg/text"
+		|
+		|var column = text.StripColumn(` + "`" + `
+		|	|name |
+		|	|a|
+		|	|bob  |
+		|` + "`" + `)
+		|
+		|var margin = text.StripMargin(` + "`" + `
+		|	|name |
+		|	|a|
+		|` + "`" + `)
+		|`)
+
+	// When
+	result, err := columnfmt.Source("a.go", []byte(src))
+
+	// Then
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := text.StripMargin(`
+		|package a
+		|
+		|import "github.com/shapestone/textsmith/pkg/text"
+		|
+		|var column = text.StripColumn(` + "`" + `
+		|	|name |
+		|	    |a|
+		|	|bob  |
+		|` + "`" + `)
+		|
+		|var margin = text.StripMargin(` + "`" + `
+		|	|name |
+		|	|a|
+		|` + "`" + `)
+		|`)
+	if diff, ok := text.Diff(expected, string(result)); !ok {
+		t.Fatalf("Unexpected source:\n%s", diff)
+	}
+}
+
+func TestSource_WithRenamedAndDotImports_RecognizesCalls(t *testing.T) {
+	// Given
+	renamed := "package a\n\nimport t \"github.com/shapestone/textsmith/pkg/text\"\n\nvar x = t.StripColumn(`\n|ab|\n|c|\r\n|de|\n`)\n"
+	dot := "package a\n\nimport . \"github.com/shapestone/textsmith/pkg/text\"\n\nvar x = StripColumn(`\n|ab|\n|c|\r\n|de|\n`)\n"
+
+	// When
+	renamedResult, err1 := columnfmt.Source("a.go", []byte(renamed))
+	dotResult, err2 := columnfmt.Source("b.go", []byte(dot))
+
+	// Then
+	if err1 != nil || err2 != nil {
+		t.Fatalf("Unexpected errors: %v, %v", err1, err2)
+	}
+	if expected := "package a\n\nimport t \"github.com/shapestone/textsmith/pkg/text\"\n\nvar x = t.StripColumn(`\n|ab|\n |c|\r\n|de|\n`)\n"; string(renamedResult) != expected {
+		t.Fatalf("Expected %q, got %q", expected, renamedResult)
+	}
+	if expected := "package a\n\nimport . \"github.com/shapestone/textsmith/pkg/text\"\n\nvar x = StripColumn(`\n|ab|\n |c|\r\n|de|\n`)\n"; string(dotResult) != expected {
+		t.Fatalf("Expected %q, got %q", expected, dotResult)
+	}
+}
+
+func TestSource_WithRaggedLiteral_AlignsEveryRow(t *testing.T) {
+	// Given - two aligned rows out of four, which margincheck does not report
+	src := text.StripMargin(`
+		|package a
+		|
+		|import "github.com/shapestone/textsmith/pkg/text"
+		|
+		|var x = text.StripColumn(` + "`" + `
+		|	|ab|
+		|	|cd|
+		|	|e|
+		|	|fgh|
+		|` + "`" + `)
+		|`)
+
+	// When
+	result, err := columnfmt.Source("a.go", []byte(src))
+
+	// Then
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := text.StripMargin(`
+		|package a
+		|
+		|import "github.com/shapestone/textsmith/pkg/text"
+		|
+		|var x = text.StripColumn(` + "`" + `
+		|	 |ab|
+		|	 |cd|
+		|	  |e|
+		|	|fgh|
+		|` + "`" + `)
+		|`)
+	if diff, ok := text.Diff(expected, string(result)); !ok {
+		t.Fatalf("Unexpected source:\n%s", diff)
+	}
+}
+
+func TestSourceWithOptions_WithSkipRagged_LeavesRaggedOrAlignedLiteralsUnchanged(t *testing.T) {
+	// Given - rendered output with ragged rows, and an aligned table with whitespace after a closing pipe
+	src := text.StripMargin(`
+		|package a
+		|
+		|import "github.com/shapestone/textsmith/pkg/text"
+		|
+		|var rendered = text.StripColumn(` + "`" + `
+		|	|1 | package main|
+		|	|2 |
+		|	|3 | func main() {}|
+		|` + "`" + `)
+		|
+		|var table = text.StripColumn(` + "`" + `
+		|	|a |` + "  " + `
+		|	|bc|
+		|` + "`" + `)
+		|`)
+
+	// When
+	result, err := columnfmt.SourceWithOptions("a.go", []byte(src), columnfmt.Options{SkipRagged: true})
+
+	// Then
+	if err != nil || string(result) != src {
+		t.Fatalf("Expected unchanged source, got %q, %v", result, err)
+	}
+}
+
+func TestSource_WithoutTextImport_ReturnsSourceUnchanged(t *testing.T) {
+	// Given
+	src := "package a\n\nfunc StripColumn(s string) string { return s }\n\nvar x = StripColumn(`\n|ab|\n|c|\n`)\n"
+
+	// When
+	result, err := columnfmt.Source("a.go", []byte(src))
+
+	// Then
+	if err != nil || string(result) != src {
+		t.Fatalf("Expected unchanged source, got %q, %v", result, err)
+	}
+}
+
+func TestSource_WithSyntaxError_ReturnsError(t *testing.T) {
+	// When
+	_, err := columnfmt.Source("a.go", []byte("package a\n\nvar x = "))
+
+	// Then
+	if err == nil {
+		t.Fatalf("Expected a parse error")
+	}
+}
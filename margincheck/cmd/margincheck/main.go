@@ -0,0 +1,18 @@
+// Command margincheck reports StripMargin and StripColumn raw string literals with lines that would be silently
+// dropped or with misaligned closing pipes.
+//
+// Usage:
+//
+//	margincheck ./...
+//	go vet -vettool=$(which margincheck) ./...
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/singlechecker"
+
+	"github.com/shapestone/textsmith/margincheck"
+)
+
+func main() {
+	singlechecker.Main(margincheck.Analyzer)
+}
@@ -0,0 +1,11 @@
+module github.com/shapestone/textsmith/margincheck
+
+go 1.22.0
+
+require github.com/shapestone/textsmith v1.2.0
+
+require (
+	golang.org/x/mod v0.23.0 // indirect
+	golang.org/x/sync v0.11.0 // indirect
+	golang.org/x/tools v0.30.0
+)
@@ -0,0 +1,174 @@
+// Package margincheck defines an analyzer that reports StripMargin and StripColumn raw string literals with lines
+// the functions would silently drop, and StripColumn literals with misaligned closing pipes.
+//
+// Code example:
+//
+//	text.StripColumn(`
+//	    |name|
+//	    |age        // margincheck: StripColumn drops this line: missing closing pipe
+//	`)
+//
+// Run it with go vet:
+//
+//	go vet -vettool=$(which margincheck) ./...
+package margincheck
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/types/typeutil"
+
+	"github.com/shapestone/textsmith/pkg/text"
+)
+
+const textPackage = "github.com/shapestone/textsmith/pkg/text"
+
+// Analyzer reports malformed StripMargin and StripColumn raw string literals
+var Analyzer = &analysis.Analyzer{
+	Name:     "margincheck",
+	Doc:      "report StripMargin and StripColumn raw string literals with dropped lines or misaligned closing pipes",
+	URL:      "https://pkg.go.dev/github.com/shapestone/textsmith/margincheck",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	inspect.Preorder([]ast.Node{(*ast.CallExpr)(nil)}, func(n ast.Node) {
+		call := n.(*ast.CallExpr)
+		name, ok := stripFunc(pass.TypesInfo, call)
+		if !ok || len(call.Args) != 1 {
+			return
+		}
+		lit, ok := call.Args[0].(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING || !strings.HasPrefix(lit.Value, "`") {
+			return
+		}
+		checkLiteral(pass, name, lit)
+	})
+	return nil, nil
+}
+
+// stripFunc returns the name of the called function when it is text.StripMargin or text.StripColumn
+func stripFunc(info *types.Info, call *ast.CallExpr) (string, bool) {
+	fn, ok := typeutil.Callee(info, call).(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != textPackage {
+		return "", false
+	}
+	switch fn.Name() {
+	case "StripMargin", "StripColumn":
+		return fn.Name(), true
+	}
+	return "", false
+}
+
+// checkLiteral reports the dropped lines and misaligned closing pipes of a raw string literal
+func checkLiteral(pass *analysis.Pass, name string, lit *ast.BasicLit) {
+	body := lit.Value[1 : len(lit.Value)-1]
+	column := name == "StripColumn"
+	pos := lit.Pos() + 1
+
+	var err error
+	if column {
+		_, err = text.StripColumnStrict(body)
+	} else {
+		_, err = text.StripMarginStrict(body)
+	}
+
+	starts := lineStarts(body)
+	var marginErr *text.MarginError
+	if errors.As(err, &marginErr) {
+		for _, p := range marginErr.Problems {
+			start := starts[p.Line-1]
+			end := start + len(p.Text)
+			diagnostic := analysis.Diagnostic{
+				Pos:     pos + token.Pos(start),
+				End:     pos + token.Pos(end),
+				Message: fmt.Sprintf("%s drops this line: %s", name, p.Reason),
+			}
+			if edit, ok := fixLine(p, column, pos+token.Pos(start)); ok {
+				diagnostic.SuggestedFixes = []analysis.SuggestedFix{{
+					Message:   "Add the missing pipe",
+					TextEdits: []analysis.TextEdit{edit},
+				}}
+			}
+			pass.Report(diagnostic)
+		}
+	}
+
+	if !column {
+		return
+	}
+	rows := columnRows(body)
+	if !misaligned(rows) {
+		return
+	}
+	for _, row := range rows {
+		if row.pad == 0 {
+			continue
+		}
+		edits := []analysis.TextEdit{{
+			Pos:     pos + token.Pos(row.pipe),
+			End:     pos + token.Pos(row.pipe),
+			NewText: []byte(strings.Repeat(" ", row.pad)),
+		}}
+		if row.end < row.lineEnd {
+			edits = append(edits, analysis.TextEdit{Pos: pos + token.Pos(row.end), End: pos + token.Pos(row.lineEnd)})
+		}
+		pass.Report(analysis.Diagnostic{
+			Pos:     pos + token.Pos(row.pipe),
+			End:     pos + token.Pos(row.lineEnd),
+			Message: "StripColumn closing pipe is not aligned with the other lines",
+			SuggestedFixes: []analysis.SuggestedFix{{
+				Message:   "Align the closing pipe",
+				TextEdits: edits,
+			}},
+		})
+	}
+}
+
+// fixLine returns an edit replacing a malformed line with one that keeps its content, when there is an obvious fix
+func fixLine(p text.MarginProblem, column bool, pos token.Pos) (analysis.TextEdit, bool) {
+	line := p.Text
+	switch p.Reason {
+	case text.ReasonMissingMargin:
+		content := strings.TrimLeft(line, " \t")
+		line = line[:len(line)-len(content)] + "|" + content
+		if column && lineReason(line) == text.ReasonMissingClosingPipe {
+			line += "|"
+		}
+	case text.ReasonMissingClosingPipe:
+		line += "|"
+	default:
+		return analysis.TextEdit{}, false
+	}
+	return analysis.TextEdit{Pos: pos, End: pos + token.Pos(len(p.Text)), NewText: []byte(line)}, true
+}
+
+// lineReason returns why StripColumn would drop a single line, or zero when it is kept
+func lineReason(line string) text.MarginReason {
+	var marginErr *text.MarginError
+	if _, err := text.StripColumnStrict(line); errors.As(err, &marginErr) {
+		return marginErr.Problems[0].Reason
+	}
+	return 0
+}
+
+// lineStarts returns the byte offset of the start of every line
+func lineStarts(s string) []int {
+	starts := []int{0}
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\n' {
+			starts = append(starts, i+1)
+		}
+	}
+	return starts
+}
@@ -0,0 +1,13 @@
+package margincheck_test
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"github.com/shapestone/textsmith/margincheck"
+)
+
+func TestAnalyzer_WithMalformedLiterals_ReportsAndFixesLines(t *testing.T) {
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), margincheck.Analyzer, "a")
+}
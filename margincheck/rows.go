@@ -0,0 +1,96 @@
+package margincheck
+
+import (
+	"strings"
+
+	"github.com/shapestone/textsmith/pkg/text"
+)
+
+// tabWidth is the tab width used to measure the indentation of literal rows, matching columnfmt
+const tabWidth = 4
+
+// row describes a well-formed |content| line of a StripColumn literal body. Offsets are byte offsets into the body.
+type row struct {
+	// pipe is the offset of the opening pipe
+	pipe int
+	// end is the offset just after the closing pipe
+	end int
+	// lineEnd is the offset of the end of the line, before any \r
+	lineEnd int
+	// pad is the number of spaces to insert before the opening pipe to align the closing pipe by display width
+	pad int
+}
+
+// columnRows returns every |content| row of a literal body, with pad set relative to the rightmost closing pipe
+func columnRows(body string) []row {
+	var rows []row
+	var closing []int
+	target := 0
+
+	start := 0
+	for _, line := range strings.Split(body, "\n") {
+		if r, column, ok := parseRow(line); ok {
+			r.pipe += start
+			r.end += start
+			r.lineEnd += start
+			rows = append(rows, r)
+			closing = append(closing, column)
+			target = max(target, column)
+		}
+		start += len(line) + 1
+	}
+
+	for i := range rows {
+		rows[i].pad = target - closing[i]
+	}
+	return rows
+}
+
+// misaligned reports whether rows look meant to be aligned but are not: most of them, and at least two, close at
+// the rightmost column while others close left of it. Literals with ragged rows, such as rendered output, are not
+// considered misaligned.
+func misaligned(rows []row) bool {
+	aligned := 0
+	for _, r := range rows {
+		if r.pad == 0 {
+			aligned++
+		}
+	}
+	return aligned >= 2 && aligned*2 > len(rows) && aligned < len(rows)
+}
+
+// parseRow recognizes a |content| line and returns the display column of its closing pipe
+func parseRow(line string) (row, int, bool) {
+	lineEnd := len(strings.TrimSuffix(line, "\r"))
+	pipe := 0
+	for pipe < lineEnd && (line[pipe] == ' ' || line[pipe] == '\t') {
+		pipe++
+	}
+	if pipe == lineEnd || line[pipe] != '|' {
+		return row{}, 0, false
+	}
+
+	end := lineEnd
+	for end > pipe && (line[end-1] == ' ' || line[end-1] == '\t') {
+		end--
+	}
+	if end-1 <= pipe || line[end-1] != '|' {
+		return row{}, 0, false
+	}
+
+	return row{pipe: pipe, end: end, lineEnd: lineEnd}, displayColumn(line[:end-1]), true
+}
+
+// displayColumn returns the display column after writing s, expanding tabs to multiples of tabWidth
+func displayColumn(s string) int {
+	column := 0
+	for {
+		i := strings.IndexByte(s, '\t')
+		if i < 0 {
+			return column + text.DisplayWidth(s)
+		}
+		column += text.DisplayWidth(s[:i])
+		column += tabWidth - column%tabWidth
+		s = s[i+1:]
+	}
+}
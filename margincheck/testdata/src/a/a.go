@@ -0,0 +1,60 @@
+package a
+
+import (
+	"github.com/shapestone/textsmith/pkg/text"
+	t "github.com/shapestone/textsmith/pkg/text"
+)
+
+func StripMargin(s string) string { return s }
+
+func margin() string {
+	// want +3 "StripMargin drops this line: missing margin"
+	return text.StripMargin(`
+		|line 1
+		line 2
+		|line 3
+	`)
+}
+
+func column() string {
+	// want +5 "StripColumn drops this line: missing closing pipe"
+	// want +5 "StripColumn drops this line: missing margin"
+	// want +5 "StripColumn drops this line: trailing content after closing pipe"
+	return text.StripColumn(`
+		|name|
+		|age
+		city|
+		|zip| 12345
+	`)
+}
+
+func misaligned() string {
+	// want +4 "StripColumn closing pipe is not aligned with the other lines"
+	return t.StripColumn(`
+		|id    |
+		|name  |
+		|x|   
+		|email |  
+	`)
+}
+
+func wellFormed() []string {
+	return []string{
+		text.StripMargin(`
+			|line 1
+			|
+			|  line 3
+		`),
+		text.StripColumn(`
+			|a |
+			|bc|
+		`),
+		text.StripColumn(`
+			|Summary: 2 changed|
+			|a.txt|
+			|b.txt|
+		`),
+		text.StripMargin("no margin"),
+		StripMargin(`no margin`),
+	}
+}
@@ -0,0 +1,6 @@
+// Package text is a stub of github.com/shapestone/textsmith/pkg/text for analyzer tests
+package text
+
+func StripMargin(s string) string { return s }
+
+func StripColumn(s string) string { return s }
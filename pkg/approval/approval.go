@@ -0,0 +1,174 @@
+// Package approval implements approval testing on top of the textsmith diff.
+//
+// A verification compares the received text with the content of an approved file stored next to the test. On a
+// mismatch the received text is written to a *.received.txt file next to the *.approved.txt file, the test fails
+// with a textsmith diff and an optional reporter is invoked, e.g. to open an external diff tool. Approving a change
+// is always an explicit step: rename or copy the received file over the approved file.
+package approval
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/shapestone/textsmith/pkg/text"
+)
+
+// ReporterEnv is the environment variable holding the reporter command used when no reporter is configured
+const ReporterEnv = "TEXTSMITH_APPROVAL_REPORTER"
+
+// TestingT is the subset of testing.TB used by Verify
+type TestingT interface {
+	Helper()
+	Name() string
+	Errorf(format string, args ...any)
+	Logf(format string, args ...any)
+}
+
+// Reporter is notified with the received and approved file paths when a verification fails
+type Reporter interface {
+	Report(received, approved string) error
+}
+
+// ReporterFunc adapts a function to the Reporter interface
+type ReporterFunc func(received, approved string) error
+
+// Report calls f(received, approved)
+func (f ReporterFunc) Report(received, approved string) error {
+	return f(received, approved)
+}
+
+// CommandReporter returns a reporter that runs an external command with the received and approved file paths
+// appended as the last two arguments, e.g. "meld" or "code --diff"
+func CommandReporter(command string) Reporter {
+	return ReporterFunc(func(received, approved string) error {
+		args := strings.Fields(command)
+		if len(args) == 0 {
+			return nil
+		}
+		cmd := exec.Command(args[0], append(args[1:], received, approved)...)
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		return cmd.Run()
+	})
+}
+
+// EnvReporter returns a reporter that runs the command held by the given environment variable, or does nothing
+// when the variable is unset or empty
+func EnvReporter(name string) Reporter {
+	return ReporterFunc(func(received, approved string) error {
+		return CommandReporter(os.Getenv(name)).Report(received, approved)
+	})
+}
+
+// Options configures where approval files are stored and how failures are reported
+type Options struct {
+	// Dir is the directory holding the approval files, "testdata" when empty
+	Dir string
+	// Name is the base file name, derived from the test name when empty
+	Name string
+	// Extension is the file extension, ".txt" when empty
+	Extension string
+	// Reporter is invoked on mismatch, EnvReporter(ReporterEnv) when nil
+	Reporter Reporter
+}
+
+// Verify compares received with the approved file of the running test using default options
+func Verify(t TestingT, received string) bool {
+	t.Helper()
+	return VerifyWithOptions(t, received, Options{})
+}
+
+// VerifyWithOptions compares received with the approved file described by opts. Line endings are normalized first,
+// so an approved file checked out with CRLF line endings still matches. On success a stale received file is removed. On mismatch the received file is written, the reporter is invoked and the test is marked as failed.
+// It returns whether the received text matched the approved text.
+func VerifyWithOptions(t TestingT, received string, opts Options) bool {
+	t.Helper()
+
+	receivedPath, approvedPath := Paths(t.Name(), opts)
+
+	approved, err := os.ReadFile(approvedPath)
+	missing := errors.Is(err, fs.ErrNotExist)
+	if err != nil && !missing {
+		t.Errorf("approval: reading approved file: %v", err)
+		return false
+	}
+
+	if !missing && normalizeLineEndings(string(approved)) == normalizeLineEndings(received) {
+		if err := os.Remove(receivedPath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("approval: removing received file: %v", err)
+			return false
+		}
+		return true
+	}
+
+	if err := os.MkdirAll(filepath.Dir(receivedPath), 0o755); err != nil {
+		t.Errorf("approval: creating approval directory: %v", err)
+		return false
+	}
+	if err := os.WriteFile(receivedPath, []byte(received), 0o644); err != nil {
+		t.Errorf("approval: writing received file: %v", err)
+		return false
+	}
+
+	reporter := opts.Reporter
+	if reporter == nil {
+		reporter = EnvReporter(ReporterEnv)
+	}
+	if err := reporter.Report(receivedPath, approvedPath); err != nil {
+		t.Logf("approval: reporter failed: %v", err)
+	}
+
+	if missing {
+		t.Errorf("approval: no approved file %s\nreview %s and rename it to approve", approvedPath, receivedPath)
+		return false
+	}
+
+	diff, _ := text.Diff(normalizeLineEndings(string(approved)), normalizeLineEndings(received))
+	t.Errorf("approval: received text does not match %s\nreview %s and rename it to approve\n\n%s",
+		approvedPath, receivedPath, diff)
+	return false
+}
+
+// Paths returns the received and approved file paths used for the named test
+func Paths(testName string, opts Options) (received, approved string) {
+	dir := opts.Dir
+	if dir == "" {
+		dir = "testdata"
+	}
+	name := opts.Name
+	if name == "" {
+		name = fileName(testName)
+	}
+	ext := opts.Extension
+	if ext == "" {
+		ext = ".txt"
+	}
+
+	base := filepath.Join(dir, name)
+	return base + ".received" + ext, base + ".approved" + ext
+}
+
+// fileName turns a test name such as "TestRender/empty input" into a portable file name
+func fileName(testName string) string {
+	var sb strings.Builder
+	for _, r := range testName {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_', r == '.':
+			sb.WriteRune(r)
+		case r == '/':
+			sb.WriteRune('.')
+		default:
+			sb.WriteRune('_')
+		}
+	}
+	return sb.String()
+}
+
+// normalizeLineEndings replaces CRLF line endings with LF
+func normalizeLineEndings(s string) string {
+	return strings.ReplaceAll(s, "\r\n", "\n")
+}
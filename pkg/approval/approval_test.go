@@ -0,0 +1,219 @@
+package approval_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/shapestone/textsmith/pkg/approval"
+	"github.com/shapestone/textsmith/pkg/text"
+)
+
+// recordingT captures failures so that failing verifications can be asserted on
+type recordingT struct {
+	name   string
+	errors []string
+	logs   []string
+}
+
+func (r *recordingT) Helper()      {}
+func (r *recordingT) Name() string { return r.name }
+func (r *recordingT) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+func (r *recordingT) Logf(format string, args ...any) {
+	r.logs = append(r.logs, fmt.Sprintf(format, args...))
+}
+
+// noReporter keeps the tests independent of the reporter environment variable
+var noReporter = approval.ReporterFunc(func(received, approved string) error { return nil })
+
+func TestVerify_WithMatchingApprovedFile_PassesAndRemovesReceivedFile(t *testing.T) {
+	// Given
+	dir := t.TempDir()
+	opts := approval.Options{Dir: dir, Reporter: noReporter}
+	received, approved := approval.Paths("TestReport", opts)
+	writeFile(t, approved, "line 1\nline 2\n")
+	writeFile(t, received, "stale")
+	rt := &recordingT{name: "TestReport"}
+
+	// When
+	ok := approval.VerifyWithOptions(rt, "line 1\nline 2\n", opts)
+
+	// Then
+	if !ok || len(rt.errors) > 0 {
+		t.Fatalf("Expected verification to pass, got errors %v", rt.errors)
+	}
+	if _, err := os.Stat(received); !os.IsNotExist(err) {
+		t.Fatalf("Expected received file to be removed, got %v", err)
+	}
+}
+
+func TestVerify_WithCRLFApprovedFile_PassesForLFReceivedText(t *testing.T) {
+	// Given - an approved file checked out with CRLF line endings
+	dir := t.TempDir()
+	opts := approval.Options{Dir: dir, Reporter: noReporter}
+	received, approved := approval.Paths("TestReport", opts)
+	writeFile(t, approved, "line 1\r\nline 2\r\n")
+	rt := &recordingT{name: "TestReport"}
+
+	// When
+	ok := approval.VerifyWithOptions(rt, "line 1\nline 2\n", opts)
+
+	// Then
+	if !ok || len(rt.errors) > 0 {
+		t.Fatalf("Expected verification to pass, got errors %v", rt.errors)
+	}
+	if _, err := os.Stat(received); !os.IsNotExist(err) {
+		t.Fatalf("Expected no received file, got %v", err)
+	}
+}
+
+func TestVerify_WithMismatch_WritesReceivedFileAndFailsWithDiff(t *testing.T) {
+	// Given
+	dir := t.TempDir()
+	opts := approval.Options{Dir: dir, Reporter: noReporter}
+	received, approved := approval.Paths("TestReport", opts)
+	writeFile(t, approved, "hello world")
+	rt := &recordingT{name: "TestReport"}
+
+	// When
+	ok := approval.VerifyWithOptions(rt, "hello there", opts)
+
+	// Then
+	if ok || len(rt.errors) != 1 {
+		t.Fatalf("Expected exactly one failure, got %v", rt.errors)
+	}
+	if content := readFile(t, received); content != "hello there" {
+		t.Fatalf("Expected received file to hold the received text, got %q", content)
+	}
+	if content := readFile(t, approved); content != "hello world" {
+		t.Fatalf("Expected approved file to stay untouched, got %q", content)
+	}
+
+	expectedDiff, _ := text.Diff("hello world", "hello there")
+	if !strings.Contains(rt.errors[0], expectedDiff) {
+		t.Fatalf("Expected failure to contain the diff, got:\n%s", rt.errors[0])
+	}
+}
+
+func TestVerify_WithMissingApprovedFile_FailsWithoutApproving(t *testing.T) {
+	// Given
+	dir := filepath.Join(t.TempDir(), "nested")
+	opts := approval.Options{Dir: dir, Reporter: noReporter}
+	received, approved := approval.Paths("TestNew", opts)
+	rt := &recordingT{name: "TestNew"}
+
+	// When
+	ok := approval.VerifyWithOptions(rt, "first output", opts)
+
+	// Then
+	if ok || len(rt.errors) != 1 {
+		t.Fatalf("Expected exactly one failure, got %v", rt.errors)
+	}
+	if !strings.Contains(rt.errors[0], "no approved file") {
+		t.Fatalf("Expected failure to mention the missing approved file, got %q", rt.errors[0])
+	}
+	if content := readFile(t, received); content != "first output" {
+		t.Fatalf("Expected received file to hold the received text, got %q", content)
+	}
+	if _, err := os.Stat(approved); !os.IsNotExist(err) {
+		t.Fatalf("Expected approved file not to be created, got %v", err)
+	}
+}
+
+func TestVerify_WithMismatch_InvokesReporterWithPaths(t *testing.T) {
+	// Given
+	var gotReceived, gotApproved string
+	reporter := approval.ReporterFunc(func(received, approved string) error {
+		gotReceived, gotApproved = received, approved
+		return fmt.Errorf("tool not installed")
+	})
+	opts := approval.Options{Dir: t.TempDir(), Reporter: reporter}
+	received, approved := approval.Paths("TestReport", opts)
+	rt := &recordingT{name: "TestReport"}
+
+	// When
+	approval.VerifyWithOptions(rt, "output", opts)
+
+	// Then
+	if gotReceived != received || gotApproved != approved {
+		t.Fatalf("Expected reporter to get %q and %q, got %q and %q", received, approved, gotReceived, gotApproved)
+	}
+	if len(rt.logs) != 1 || !strings.Contains(rt.logs[0], "tool not installed") {
+		t.Fatalf("Expected reporter error to be logged, got %v", rt.logs)
+	}
+}
+
+func TestVerify_WithMatch_DoesNotInvokeReporter(t *testing.T) {
+	// Given
+	called := false
+	reporter := approval.ReporterFunc(func(received, approved string) error {
+		called = true
+		return nil
+	})
+	opts := approval.Options{Dir: t.TempDir(), Reporter: reporter}
+	_, approved := approval.Paths("TestReport", opts)
+	writeFile(t, approved, "same")
+
+	// When
+	approval.VerifyWithOptions(&recordingT{name: "TestReport"}, "same", opts)
+
+	// Then
+	if called {
+		t.Fatalf("Expected reporter not to be invoked on success")
+	}
+}
+
+func TestPaths_WithSubtestName_ReturnsPortableFileNames(t *testing.T) {
+	// Given
+	opts := approval.Options{}
+
+	// When
+	received, approved := approval.Paths("TestRender/empty input", opts)
+
+	// Then
+	if received != filepath.Join("testdata", "TestRender.empty_input.received.txt") {
+		t.Errorf("Unexpected received path %q", received)
+	}
+	if approved != filepath.Join("testdata", "TestRender.empty_input.approved.txt") {
+		t.Errorf("Unexpected approved path %q", approved)
+	}
+}
+
+func TestPaths_WithCustomNameAndExtension_UsesOptions(t *testing.T) {
+	// Given
+	opts := approval.Options{Dir: "golden", Name: "report", Extension: ".json"}
+
+	// When
+	received, approved := approval.Paths("TestIgnored", opts)
+
+	// Then
+	if received != filepath.Join("golden", "report.received.json") {
+		t.Errorf("Unexpected received path %q", received)
+	}
+	if approved != filepath.Join("golden", "report.approved.json") {
+		t.Errorf("Unexpected approved path %q", approved)
+	}
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
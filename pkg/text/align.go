@@ -0,0 +1,109 @@
+package text
+
+import (
+	"strings"
+)
+
+// Alignment selects how Align places text within a line
+type Alignment int
+
+const (
+	// AlignLeft places text at the start of the line
+	AlignLeft Alignment = iota
+	// AlignRight places text at the end of the line
+	AlignRight
+	// AlignCenter places text in the middle of the line, with the odd column of padding on the right
+	AlignCenter
+	// AlignJustify stretches the spaces between words so the text fills the line, except on the last line of a
+	// paragraph
+	AlignJustify
+)
+
+// PadRight appends spaces to s until it is width display columns wide
+func PadRight(s string, width int) string {
+	return s + strings.Repeat(" ", max(0, width-DisplayWidth(s)))
+}
+
+// PadLeft prepends spaces to s until it is width display columns wide
+func PadLeft(s string, width int) string {
+	return strings.Repeat(" ", max(0, width-DisplayWidth(s))) + s
+}
+
+// PadCenter surrounds s with spaces until it is width display columns wide, putting the odd column on the right
+func PadCenter(s string, width int) string {
+	extra := max(0, width-DisplayWidth(s))
+	return strings.Repeat(" ", extra/2) + s + strings.Repeat(" ", extra-extra/2)
+}
+
+// Align aligns every line of s within width display columns. Leading and trailing spaces and tabs of each line are
+// removed first, and every line narrower than width is padded with spaces to exactly width, so the result is a
+// rectangular block. Lines wider than width are only trimmed. Line endings are kept, and the empty text after a
+// trailing line ending is not padded.
+//
+// With AlignJustify, words are separated by at least one space and the extra spaces are spread evenly over the
+// gaps of a line. The last line of a paragraph, followed by an empty line or the end of the text, and lines with a
+// single word are aligned left.
+//
+// Code example:
+//
+//	text.Align("left\nand right", 12, text.AlignRight)
+//	// "        left\n   and right"
+func Align(s string, width int, alignment Alignment) string {
+	lines := splitSourceLines(s)
+
+	var sb strings.Builder
+	sb.Grow(len(s))
+	for i, line := range lines {
+		if i == len(lines)-1 && i > 0 && line.text == "" {
+			// The empty text after a trailing line ending is not a line
+			break
+		}
+		content := strings.Trim(line.text, " \t")
+		switch alignment {
+		case AlignRight:
+			sb.WriteString(PadLeft(content, width))
+		case AlignCenter:
+			sb.WriteString(PadCenter(content, width))
+		case AlignJustify:
+			last := i == len(lines)-1 || strings.Trim(lines[i+1].text, " \t") == ""
+			sb.WriteString(justifyLine(content, width, last))
+		default:
+			sb.WriteString(PadRight(content, width))
+		}
+		sb.WriteString(line.ending)
+	}
+	return sb.String()
+}
+
+// Justify aligns every line of s to width display columns with full justification, see Align
+func Justify(s string, width int) string {
+	return Align(s, width, AlignJustify)
+}
+
+// justifyLine spreads the spaces between the words of a trimmed line so that it is width columns wide
+func justifyLine(line string, width int, last bool) string {
+	words := strings.FieldsFunc(line, func(r rune) bool { return r == ' ' || r == '\t' })
+	if last || len(words) < 2 {
+		return PadRight(line, width)
+	}
+
+	extra := width - len(words) + 1
+	for _, word := range words {
+		extra -= DisplayWidth(word)
+	}
+	if extra < 0 {
+		return line
+	}
+
+	// Gap i receives one of the remaining spaces when the running share crosses an integer, which spreads them
+	// evenly instead of piling them up on the left
+	gaps := len(words) - 1
+	var sb strings.Builder
+	sb.WriteString(words[0])
+	for i, word := range words[1:] {
+		spaces := 1 + extra/gaps + (i+1)*(extra%gaps)/gaps - i*(extra%gaps)/gaps
+		sb.WriteString(strings.Repeat(" ", spaces))
+		sb.WriteString(word)
+	}
+	return sb.String()
+}
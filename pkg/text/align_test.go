@@ -0,0 +1,136 @@
+package text_test
+
+import (
+	"testing"
+
+	"github.com/shapestone/textsmith/pkg/text"
+)
+
+func TestAlign_WithRightAlignment_PadsOnTheLeft(t *testing.T) {
+	// Given
+	input := "left\n  and right  "
+
+	// When
+	result := text.Align(input, 12, text.AlignRight)
+
+	// Then
+	expected := "        left\n   and right"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestAlign_WithLeftAlignment_ProducesRectangularBlock(t *testing.T) {
+	// Given
+	input := "\tone\r\n\r\nthree"
+
+	// When
+	result := text.Align(input, 5, text.AlignLeft)
+
+	// Then
+	expected := "one  \r\n     \r\nthree"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestAlign_WithTrailingNewline_DoesNotPadTextAfterIt(t *testing.T) {
+	// Given
+	input := "a\nbb\n"
+
+	// When
+	result := text.Align(input, 5, text.AlignLeft)
+
+	// Then
+	expected := "a    \nbb   \n"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestAlign_WithCenterAlignment_PutsOddColumnOnTheRight(t *testing.T) {
+	// Given
+	input := "ab\nabc"
+
+	// When
+	result := text.Align(input, 6, text.AlignCenter)
+
+	// Then
+	expected := "  ab  \n abc  "
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestAlign_WithWideCharacters_MeasuresDisplayWidth(t *testing.T) {
+	// Given
+	input := "世界\nab"
+
+	// When
+	result := text.Align(input, 6, text.AlignRight)
+
+	// Then
+	expected := "  世界\n    ab"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestAlign_WithLineWiderThanWidth_OnlyTrimsIt(t *testing.T) {
+	// When
+	result := text.Align("  too wide for it ", 5, text.AlignCenter)
+
+	// Then
+	expected := "too wide for it"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestJustify_WithParagraphs_StretchesAllButLastLines(t *testing.T) {
+	// Given
+	input := text.Wrap("Justified text fills every line of a paragraph except the last one.", 20) +
+		"\n\nA second paragraph\nends ragged too."
+
+	// When
+	result := text.Justify(input, 20)
+
+	// Then
+	expected := text.StripColumn(`
+		|Justified text fills|
+		|every  line   of   a|
+		|paragraph except the|
+		|last one.           |
+		|                    |
+		|A  second  paragraph|
+		|ends ragged too.    |
+	`)
+	if diff, ok := text.Diff(expected, result); !ok {
+		t.Fatalf("Unexpected justification:\n%s", diff)
+	}
+}
+
+func TestJustify_WithUnevenSpaces_SpreadsThemEvenly(t *testing.T) {
+	// Given
+	input := "a b c d e\nend"
+
+	// When
+	result := text.Justify(input, 12)
+
+	// Then
+	expected := "a b  c  d  e\nend         "
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestPadRight_WithWideCharacters_PadsToDisplayWidth(t *testing.T) {
+	// When
+	result := text.PadRight("日本", 6) + "|"
+
+	// Then
+	expected := "日本  |"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
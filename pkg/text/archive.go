@@ -0,0 +1,145 @@
+package text
+
+import (
+	"io/fs"
+	"strings"
+	"testing/fstest"
+)
+
+// Archive is a txtar-style collection of files: a free-form comment followed by files, each introduced by a
+// marker line of the form "-- path --"
+type Archive struct {
+	Comment string
+	Files   []ArchiveFile
+}
+
+// ArchiveFile is a single named file of an Archive
+type ArchiveFile struct {
+	Name string
+	Data string
+}
+
+// ParseArchive parses a txtar-style archive. It works directly on the output of StripMargin, so one literal can
+// describe several files:
+//
+//	archive := text.ParseArchive(text.StripMargin(`
+//	|input and expected output
+//	|-- input.txt --
+//	|hello
+//	|-- expected.txt --
+//	|HELLO
+//	|`))
+//
+// Everything before the first marker line is the comment. Marker lines ending with \r\n are recognized as well.
+func ParseArchive(s string) Archive {
+	var a Archive
+
+	comment, rest, found := splitAtMarker(s)
+	a.Comment = comment
+	for found {
+		var name, data string
+		name, rest = markerName(rest)
+		data, rest, found = splitAtMarker(rest)
+		a.Files = append(a.Files, ArchiveFile{Name: name, Data: data})
+	}
+
+	return a
+}
+
+// splitAtMarker returns the text before the first marker line and the text starting at that marker line
+func splitAtMarker(s string) (before, after string, found bool) {
+	for offset := 0; ; {
+		line := s[offset:]
+		end := strings.IndexByte(line, '\n')
+		if end >= 0 {
+			line = line[:end]
+		}
+		if _, ok := parseMarker(line); ok {
+			return s[:offset], s[offset:], true
+		}
+		if end < 0 {
+			return s, "", false
+		}
+		offset += end + 1
+	}
+}
+
+// markerName consumes the marker line at the start of s and returns the file name and the remaining text
+func markerName(s string) (name, rest string) {
+	line, rest := s, ""
+	if i := strings.IndexByte(s, '\n'); i >= 0 {
+		line, rest = s[:i], s[i+1:]
+	}
+	name, _ = parseMarker(line)
+	return name, rest
+}
+
+// parseMarker reports whether a line is a "-- path --" marker and returns the path
+func parseMarker(line string) (string, bool) {
+	line = strings.TrimSuffix(line, "\r")
+	if !strings.HasPrefix(line, "-- ") || !strings.HasSuffix(line, " --") || len(line) < len("-- x --") {
+		return "", false
+	}
+	name := strings.TrimSpace(line[len("-- ") : len(line)-len(" --")])
+	return name, name != ""
+}
+
+// Format renders the archive in txtar form. The comment and every file are terminated with a newline when they
+// are not empty and do not already end with one, so that each marker starts on its own line.
+func (a Archive) Format() string {
+	var sb strings.Builder
+	sb.WriteString(withNewline(a.Comment))
+	for _, f := range a.Files {
+		sb.WriteString("-- " + f.Name + " --\n")
+		sb.WriteString(withNewline(f.Data))
+	}
+	return sb.String()
+}
+
+// withNewline appends a newline to non-empty text that does not end with one
+func withNewline(s string) string {
+	if s == "" || strings.HasSuffix(s, "\n") {
+		return s
+	}
+	return s + "\n"
+}
+
+// File returns the data of the named file and whether the archive contains it
+func (a Archive) File(name string) (string, bool) {
+	for _, f := range a.Files {
+		if f.Name == name {
+			return f.Data, true
+		}
+	}
+	return "", false
+}
+
+// MapFS converts the archive to an in-memory file system, e.g. to feed the code under test. When a name occurs
+// more than once the last file wins.
+func (a Archive) MapFS() fstest.MapFS {
+	fsys := fstest.MapFS{}
+	for _, f := range a.Files {
+		fsys[f.Name] = &fstest.MapFile{Data: []byte(f.Data), Mode: 0o644}
+	}
+	return fsys
+}
+
+// ArchiveFromFS builds an archive from all regular files of a file system in lexical path order
+func ArchiveFromFS(fsys fs.FS) (Archive, error) {
+	var a Archive
+	err := fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return err
+		}
+		a.Files = append(a.Files, ArchiveFile{Name: name, Data: string(data)})
+		return nil
+	})
+	if err != nil {
+		return Archive{}, err
+	}
+	return a, nil
+}
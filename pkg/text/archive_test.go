@@ -0,0 +1,206 @@
+package text_test
+
+import (
+	"io/fs"
+	"testing"
+	"testing/fstest"
+
+	"github.com/shapestone/textsmith/pkg/text"
+)
+
+func TestParseArchive_WithStripMarginLiteral_ReturnsCommentAndFiles(t *testing.T) {
+	// Given
+	input := text.StripMargin(`
+		|Upper-cases the input.
+		|-- input.txt --
+		|hello
+		|world
+		|-- expected.txt --
+		|HELLO
+		|WORLD
+		|`)
+
+	// When
+	archive := text.ParseArchive(input)
+
+	// Then
+	if archive.Comment != "Upper-cases the input.\n" {
+		t.Fatalf("Expected comment %q, got %q", "Upper-cases the input.\n", archive.Comment)
+	}
+	if len(archive.Files) != 2 {
+		t.Fatalf("Expected 2 files, got %d", len(archive.Files))
+	}
+	if archive.Files[0].Name != "input.txt" || archive.Files[0].Data != "hello\nworld\n" {
+		t.Errorf("Unexpected first file %+v", archive.Files[0])
+	}
+	if archive.Files[1].Name != "expected.txt" || archive.Files[1].Data != "HELLO\nWORLD\n" {
+		t.Errorf("Unexpected second file %+v", archive.Files[1])
+	}
+}
+
+func TestParseArchive_WithoutTrailingNewline_KeepsLastFileAsIs(t *testing.T) {
+	// Given
+	input := text.StripMargin(`
+		|-- a.txt --
+		|alpha
+		|-- b.txt --
+		|beta`)
+
+	// When
+	archive := text.ParseArchive(input)
+
+	// Then
+	if archive.Comment != "" {
+		t.Errorf("Expected empty comment, got %q", archive.Comment)
+	}
+	if data, ok := archive.File("b.txt"); !ok || data != "beta" {
+		t.Errorf("Expected b.txt to hold %q, got %q (found %t)", "beta", data, ok)
+	}
+}
+
+func TestParseArchive_WithEmptyFileAndNestedPath_ParsesAllMarkers(t *testing.T) {
+	// Given
+	input := "-- empty.txt --\n-- dir/sub/file.go --\npackage sub\n"
+
+	// When
+	archive := text.ParseArchive(input)
+
+	// Then
+	expected := []text.ArchiveFile{
+		{Name: "empty.txt", Data: ""},
+		{Name: "dir/sub/file.go", Data: "package sub\n"},
+	}
+	if len(archive.Files) != len(expected) {
+		t.Fatalf("Expected %d files, got %d", len(expected), len(archive.Files))
+	}
+	for i := range expected {
+		if archive.Files[i] != expected[i] {
+			t.Errorf("Expected %+v, got %+v", expected[i], archive.Files[i])
+		}
+	}
+}
+
+func TestParseArchive_WithCRLFMarkers_RecognizesMarkers(t *testing.T) {
+	// Given
+	input := "comment\r\n-- a.txt --\r\nline\r\n"
+
+	// When
+	archive := text.ParseArchive(input)
+
+	// Then
+	if archive.Comment != "comment\r\n" {
+		t.Errorf("Expected comment %q, got %q", "comment\r\n", archive.Comment)
+	}
+	if data, ok := archive.File("a.txt"); !ok || data != "line\r\n" {
+		t.Errorf("Expected a.txt to hold %q, got %q (found %t)", "line\r\n", data, ok)
+	}
+}
+
+func TestParseArchive_WithMarkerLookalikes_TreatsThemAsContent(t *testing.T) {
+	// Given
+	input := "--  --\n -- a.txt --\n-- a.txt--\n"
+
+	// When
+	archive := text.ParseArchive(input)
+
+	// Then
+	if len(archive.Files) != 0 {
+		t.Fatalf("Expected no files, got %+v", archive.Files)
+	}
+	if archive.Comment != input {
+		t.Fatalf("Expected whole input as comment, got %q", archive.Comment)
+	}
+}
+
+func TestArchiveFormat_WithMissingNewlines_TerminatesEachSection(t *testing.T) {
+	// Given
+	archive := text.Archive{
+		Comment: "fixture",
+		Files: []text.ArchiveFile{
+			{Name: "a.txt", Data: "alpha"},
+			{Name: "b.txt", Data: "beta\n"},
+		},
+	}
+
+	// When
+	result := archive.Format()
+
+	// Then
+	expected := text.StripMargin(`
+		|fixture
+		|-- a.txt --
+		|alpha
+		|-- b.txt --
+		|beta
+		|`)
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestArchiveFormat_WithParsedArchive_RoundTrips(t *testing.T) {
+	// Given
+	input := "comment\n-- a.txt --\nalpha\n-- b/c.txt --\nbeta\n"
+
+	// When
+	result := text.ParseArchive(input).Format()
+
+	// Then
+	if result != input {
+		t.Fatalf("Expected %q, got %q", input, result)
+	}
+}
+
+func TestArchiveMapFS_WithFiles_ServesFileContents(t *testing.T) {
+	// Given
+	archive := text.ParseArchive(text.StripMargin(`
+		|-- config/app.yaml --
+		|port: 8080
+		|-- main.go --
+		|package main
+		|`))
+
+	// When
+	fsys := archive.MapFS()
+
+	// Then
+	data, err := fs.ReadFile(fsys, "config/app.yaml")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if string(data) != "port: 8080\n" {
+		t.Fatalf("Expected %q, got %q", "port: 8080\n", string(data))
+	}
+	if err := fstest.TestFS(fsys, "config/app.yaml", "main.go"); err != nil {
+		t.Fatalf("Expected a valid file system: %v", err)
+	}
+}
+
+func TestArchiveFromFS_WithMapFS_ReturnsFilesInLexicalOrder(t *testing.T) {
+	// Given
+	fsys := fstest.MapFS{
+		"z.txt":     {Data: []byte("last\n")},
+		"a/b.txt":   {Data: []byte("nested\n")},
+		"a/dir/c.x": {Data: []byte("deep\n")},
+	}
+
+	// When
+	archive, err := text.ArchiveFromFS(fsys)
+
+	// Then
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := text.StripMargin(`
+		|-- a/b.txt --
+		|nested
+		|-- a/dir/c.x --
+		|deep
+		|-- z.txt --
+		|last
+		|`)
+	if result := archive.Format(); result != expected {
+		diff, _ := text.Diff(expected, result)
+		t.Fatalf("Formatted archive does not match expected:\n\n%s", diff)
+	}
+}
@@ -0,0 +1,143 @@
+package text
+
+import (
+	"strings"
+)
+
+// BoxStyle selects the border characters of BoxWithOptions
+type BoxStyle int
+
+const (
+	// BoxSingle draws ┌─┐ borders
+	BoxSingle BoxStyle = iota
+	// BoxDouble draws ╔═╗ borders
+	BoxDouble
+	// BoxRounded draws ╭─╮ borders
+	BoxRounded
+	// BoxHeavy draws ┏━┓ borders
+	BoxHeavy
+	// BoxASCII draws +-+ borders
+	BoxASCII
+)
+
+var (
+	doubleBorder  = borderChars{"═", "║", "╔", "╦", "╗", "╠", "╬", "╣", "╚", "╩", "╝"}
+	roundedBorder = borderChars{"─", "│", "╭", "┬", "╮", "├", "┼", "┤", "╰", "┴", "╯"}
+	heavyBorder   = borderChars{"━", "┃", "┏", "┳", "┓", "┣", "╋", "┫", "┗", "┻", "┛"}
+)
+
+// chars returns the border characters of the style
+func (s BoxStyle) chars() borderChars {
+	switch s {
+	case BoxDouble:
+		return doubleBorder
+	case BoxRounded:
+		return roundedBorder
+	case BoxHeavy:
+		return heavyBorder
+	case BoxASCII:
+		return asciiBorder
+	}
+	return unicodeBorder
+}
+
+// BoxOptions configures BoxWithOptions
+type BoxOptions struct {
+	// Style is the border style, BoxSingle by default
+	Style BoxStyle
+	// Padding is the number of spaces between the side borders and the text
+	Padding int
+	// VerticalPadding is the number of empty lines between the top and bottom borders and the text
+	VerticalPadding int
+	// Title is shown in the top border
+	Title string
+	// MaxWidth is the maximum display width of the box including its borders. Longer lines are wrapped and cut when
+	// they cannot be wrapped. Padding is reduced and the title left out when they do not fit, so only a MaxWidth
+	// below 3 is exceeded. Unlimited when zero or negative.
+	MaxWidth int
+	// Align aligns the lines of text within the box. With the default AlignLeft lines are kept as written,
+	// including their indentation; the other alignments trim lines like Align.
+	Align Alignment
+}
+
+// Box frames a multi-line string with a single-line border and one space of padding
+//
+// Code example:
+//
+//	text.Box("Build passed\n12 tests")
+//	// ┌──────────────┐
+//	// │ Build passed │
+//	// │ 12 tests     │
+//	// └──────────────┘
+func Box(s string) string {
+	return BoxWithOptions(s, BoxOptions{Padding: 1})
+}
+
+// BoxWithOptions frames a multi-line string with a border style, padding, title, maximum width and alignment.
+// Widths are measured in display width. Tabs and other control characters have no display width, so expand them
+// before framing.
+//
+// Code example:
+//
+//	text.BoxWithOptions("All 12 tests passed", text.BoxOptions{Style: text.BoxRounded, Padding: 1, Title: "Result"})
+//	// ╭─ Result ────────────╮
+//	// │ All 12 tests passed │
+//	// ╰─────────────────────╯
+func BoxWithOptions(s string, opts BoxOptions) string {
+	b := opts.Style.chars()
+	padding := max(0, opts.Padding)
+	title := opts.Title
+
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	if opts.MaxWidth > 0 {
+		// Padding gives way to the text, so that at least one column of text fits between the borders
+		padding = min(padding, max(0, (opts.MaxWidth-3)/2))
+		limit := max(1, opts.MaxWidth-2-2*padding)
+		lines := strings.Split(Wrap(s, limit), "\n")
+		for i, line := range lines {
+			lines[i] = Truncate(line, limit)
+		}
+		s = strings.Join(lines, "\n")
+		if opts.MaxWidth < 7 {
+			title = ""
+		} else if title != "" {
+			title = Truncate(title, opts.MaxWidth-6)
+		}
+	}
+	lines := strings.Split(s, "\n")
+
+	width := 0
+	for _, line := range lines {
+		width = max(width, DisplayWidth(line))
+	}
+	inner := width + 2*padding
+	if title != "" {
+		// The title is written as "─ title " and needs at least one more border character after it
+		inner = max(inner, DisplayWidth(title)+4)
+	}
+	width = inner - 2*padding
+
+	result := make([]string, 0, len(lines)+2+2*opts.VerticalPadding)
+	if title != "" {
+		result = append(result, b.topLeft+b.horizontal+" "+title+" "+
+			strings.Repeat(b.horizontal, inner-DisplayWidth(title)-3)+b.topRight)
+	} else {
+		result = append(result, b.topLeft+strings.Repeat(b.horizontal, inner)+b.topRight)
+	}
+	empty := b.vertical + strings.Repeat(" ", inner) + b.vertical
+	for i := 0; i < opts.VerticalPadding; i++ {
+		result = append(result, empty)
+	}
+	if opts.Align != AlignLeft {
+		lines = strings.Split(Align(strings.Join(lines, "\n"), width, opts.Align), "\n")
+	}
+	pad := strings.Repeat(" ", padding)
+	for _, line := range lines {
+		result = append(result, b.vertical+pad+PadRight(line, width)+pad+b.vertical)
+	}
+	for i := 0; i < opts.VerticalPadding; i++ {
+		result = append(result, empty)
+	}
+	result = append(result, b.bottomLeft+strings.Repeat(b.horizontal, inner)+b.bottomRight)
+	return strings.Join(result, "\n")
+}
@@ -0,0 +1,155 @@
+package text_test
+
+import (
+	"testing"
+
+	"github.com/shapestone/textsmith/pkg/text"
+)
+
+func TestBox_WithMultiLineText_FramesEveryLine(t *testing.T) {
+	// Given
+	input := "Build passed\n  12 tests"
+
+	// When
+	result := text.Box(input)
+
+	// Then
+	expected := text.StripMargin(`
+		|┌──────────────┐
+		|│ Build passed │
+		|│   12 tests   │
+		|└──────────────┘`)
+	if diff, ok := text.Diff(expected, result); !ok {
+		t.Fatalf("Unexpected box:\n%s", diff)
+	}
+}
+
+func TestBoxWithOptions_WithTitle_WritesTitleInTopBorder(t *testing.T) {
+	// Given
+	opts := text.BoxOptions{Style: text.BoxRounded, Padding: 1, Title: "Result"}
+
+	// When
+	result := text.BoxWithOptions("All 12 tests passed", opts)
+
+	// Then
+	expected := text.StripMargin(`
+		|╭─ Result ────────────╮
+		|│ All 12 tests passed │
+		|╰─────────────────────╯`)
+	if diff, ok := text.Diff(expected, result); !ok {
+		t.Fatalf("Unexpected box:\n%s", diff)
+	}
+}
+
+func TestBoxWithOptions_WithTitleWiderThanText_WidensBox(t *testing.T) {
+	// Given
+	opts := text.BoxOptions{Style: text.BoxASCII, Title: "Summary"}
+
+	// When
+	result := text.BoxWithOptions("ok", opts)
+
+	// Then
+	expected := text.StripMargin(`
+		|+- Summary -+
+		||ok         |
+		|+-----------+`)
+	if diff, ok := text.Diff(expected, result); !ok {
+		t.Fatalf("Unexpected box:\n%s", diff)
+	}
+}
+
+func TestBoxWithOptions_WithStyles_UsesStyleCharacters(t *testing.T) {
+	// Given
+	cases := map[text.BoxStyle]string{
+		text.BoxSingle:  "┌─┐\n│x│\n└─┘",
+		text.BoxDouble:  "╔═╗\n║x║\n╚═╝",
+		text.BoxRounded: "╭─╮\n│x│\n╰─╯",
+		text.BoxHeavy:   "┏━┓\n┃x┃\n┗━┛",
+		text.BoxASCII:   "+-+\n|x|\n+-+",
+	}
+
+	for style, expected := range cases {
+		// When
+		result := text.BoxWithOptions("x", text.BoxOptions{Style: style})
+
+		// Then
+		if result != expected {
+			t.Errorf("Expected %q for style %d, got %q", expected, style, result)
+		}
+	}
+}
+
+func TestBoxWithOptions_WithMaxWidth_WrapsAndCutsText(t *testing.T) {
+	// Given
+	input := "the quick brown fox\nhttps://example.com/averyveryverylongsegment"
+	opts := text.BoxOptions{Style: text.BoxDouble, Padding: 1, MaxWidth: 16}
+
+	// When
+	result := text.BoxWithOptions(input, opts)
+
+	// Then
+	expected := text.StripMargin(`
+		|╔══════════════╗
+		|║ the quick    ║
+		|║ brown fox    ║
+		|║ https://     ║
+		|║ example.com/ ║
+		|║ averyveryve… ║
+		|╚══════════════╝`)
+	if diff, ok := text.Diff(expected, result); !ok {
+		t.Fatalf("Unexpected box:\n%s", diff)
+	}
+}
+
+func TestBoxWithOptions_WithVerticalPaddingAndCenter_CentersWideText(t *testing.T) {
+	// Given
+	opts := text.BoxOptions{Style: text.BoxHeavy, Padding: 2, VerticalPadding: 1, Align: text.AlignCenter}
+
+	// When
+	result := text.BoxWithOptions("成功\ndone!", opts)
+
+	// Then
+	expected := text.StripColumn(`
+		|┏━━━━━━━━━┓|
+		|┃         ┃|
+		|┃  成功   ┃|
+		|┃  done!  ┃|
+		|┃         ┃|
+		|┗━━━━━━━━━┛|
+	`)
+	if diff, ok := text.Diff(expected, result); !ok {
+		t.Fatalf("Unexpected box:\n%s", diff)
+	}
+}
+
+func TestBoxWithOptions_WithMaxWidthAndLongTitle_CutsTitle(t *testing.T) {
+	// Given
+	opts := text.BoxOptions{Style: text.BoxASCII, Title: "A very long title", MaxWidth: 10}
+
+	// When
+	result := text.BoxWithOptions("ok", opts)
+
+	// Then
+	expected := "+- A v… -+\n|ok      |\n+--------+"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestBoxWithOptions_WithPaddingWiderThanMaxWidth_ReducesPadding(t *testing.T) {
+	// Given
+	opts := text.BoxOptions{Padding: 3, MaxWidth: 6, Title: "Status"}
+
+	// When
+	result := text.BoxWithOptions("hello world", opts)
+
+	// Then
+	expected := text.StripMargin(`
+		|┌────┐
+		|│ h… │
+		|│ w… │
+		|└────┘`)
+	if diff, ok := text.Diff(expected, result); !ok {
+		t.Fatalf("Unexpected box:\n%s", diff)
+	}
+}
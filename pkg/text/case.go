@@ -0,0 +1,206 @@
+package text
+
+import (
+	"strings"
+	"unicode"
+)
+
+// CaseStyle selects the identifier style ConvertCase produces
+type CaseStyle int
+
+const (
+	// CaseCamel joins words with the first word lowercase and the others capitalized, e.g. httpServerID
+	CaseCamel CaseStyle = iota
+	// CasePascal joins capitalized words, e.g. HTTPServerID
+	CasePascal
+	// CaseSnake joins lowercase words with underscores, e.g. http_server_id
+	CaseSnake
+	// CaseKebab joins lowercase words with hyphens, e.g. http-server-id
+	CaseKebab
+	// CaseScreamingSnake joins uppercase words with underscores, e.g. HTTP_SERVER_ID
+	CaseScreamingSnake
+	// CaseTitle joins capitalized words with spaces, e.g. HTTP Server ID
+	CaseTitle
+)
+
+// DefaultInitialisms are the initialisms written in all caps in camel, Pascal and title case, following the Go
+// lint conventions
+var DefaultInitialisms = []string{
+	"ACL", "API", "ASCII", "CPU", "CSS", "DNS", "EOF", "GUID", "HTML", "HTTP", "HTTPS", "ID", "IP", "JSON", "LHS",
+	"QPS", "RAM", "RHS", "RPC", "SLA", "SMTP", "SQL", "SSH", "TCP", "TLS", "TTL", "UDP", "UI", "UID", "UUID", "URI",
+	"URL", "UTF8", "VM", "XML", "XMPP", "XSRF", "XSS",
+}
+
+// CaseOptions configures ConvertCase
+type CaseOptions struct {
+	// Initialisms are written in all caps in camel, Pascal and title case, DefaultInitialisms when nil
+	Initialisms []string
+	// NoInitialisms capitalizes every word the same way, e.g. HttpServerId
+	NoInitialisms bool
+}
+
+// initialisms returns the configured initialisms as a set of uppercase words
+func (o CaseOptions) initialisms() map[string]bool {
+	if o.NoInitialisms {
+		return nil
+	}
+	list := o.Initialisms
+	if list == nil {
+		list = DefaultInitialisms
+	}
+	set := make(map[string]bool, len(list))
+	for _, word := range list {
+		set[strings.ToUpper(word)] = true
+	}
+	return set
+}
+
+// CamelCase converts an identifier or phrase to camelCase, e.g. "HTTP server id" to "httpServerID"
+func CamelCase(s string) string {
+	return ConvertCase(s, CaseCamel, CaseOptions{})
+}
+
+// PascalCase converts an identifier or phrase to PascalCase, e.g. "http_server_id" to "HTTPServerID"
+func PascalCase(s string) string {
+	return ConvertCase(s, CasePascal, CaseOptions{})
+}
+
+// SnakeCase converts an identifier or phrase to snake_case, e.g. "HTTPServerID" to "http_server_id"
+func SnakeCase(s string) string {
+	return ConvertCase(s, CaseSnake, CaseOptions{})
+}
+
+// KebabCase converts an identifier or phrase to kebab-case, e.g. "HTTPServerID" to "http-server-id"
+func KebabCase(s string) string {
+	return ConvertCase(s, CaseKebab, CaseOptions{})
+}
+
+// ScreamingSnakeCase converts an identifier or phrase to SCREAMING_SNAKE_CASE, e.g. "httpServerID" to
+// "HTTP_SERVER_ID"
+func ScreamingSnakeCase(s string) string {
+	return ConvertCase(s, CaseScreamingSnake, CaseOptions{})
+}
+
+// TitleCase converts an identifier or phrase to Title Case, e.g. "http_server_id" to "HTTP Server ID"
+func TitleCase(s string) string {
+	return ConvertCase(s, CaseTitle, CaseOptions{})
+}
+
+// ConvertCase splits s into words and joins them in the given style. Words are separated by any character that
+// is not a letter or digit, by a lowercase letter or digit followed by an uppercase letter, and by the last
+// uppercase letter of an acronym followed by a lowercase letter, so "HTTPServerID" splits into HTTP, Server and
+// ID. Digits stay with the preceding word, and a plural initialism such as "IDs" stays one word. Letters are
+// converted with the Unicode case mappings.
+//
+// Code example:
+//
+//	text.ConvertCase("user_ids_by_url", text.CasePascal, text.CaseOptions{}) // "UserIDsByURL"
+//	text.ConvertCase("k8s_api", text.CasePascal, text.CaseOptions{
+//		Initialisms: append([]string{"K8S"}, text.DefaultInitialisms...),
+//	})
+//	// "K8SAPI"
+func ConvertCase(s string, style CaseStyle, opts CaseOptions) string {
+	initialisms := opts.initialisms()
+	words := splitWords(s, initialisms)
+
+	var sb strings.Builder
+	sb.Grow(len(s) + len(words))
+	for i, word := range words {
+		switch style {
+		case CaseCamel:
+			if i == 0 {
+				sb.WriteString(strings.ToLower(word))
+			} else {
+				sb.WriteString(capitalizeWord(word, initialisms))
+			}
+		case CasePascal:
+			sb.WriteString(capitalizeWord(word, initialisms))
+		case CaseSnake, CaseKebab, CaseScreamingSnake:
+			if i > 0 {
+				if style == CaseKebab {
+					sb.WriteByte('-')
+				} else {
+					sb.WriteByte('_')
+				}
+			}
+			if style == CaseScreamingSnake {
+				sb.WriteString(strings.ToUpper(word))
+			} else {
+				sb.WriteString(strings.ToLower(word))
+			}
+		case CaseTitle:
+			if i > 0 {
+				sb.WriteByte(' ')
+			}
+			sb.WriteString(capitalizeWord(word, initialisms))
+		}
+	}
+	return sb.String()
+}
+
+// capitalizeWord writes an initialism, optionally followed by digits, in all caps, a plural initialism with a
+// lowercase s, and any other word with a title case first letter and lowercase rest
+func capitalizeWord(word string, initialisms map[string]bool) string {
+	upper := strings.ToUpper(word)
+	if initialisms[upper] || initialisms[strings.TrimRight(upper, "0123456789")] {
+		return upper
+	}
+	if isPluralInitialism(word, initialisms) {
+		return upper[:len(upper)-1] + "s"
+	}
+	runes := []rune(strings.ToLower(word))
+	runes[0] = unicode.ToTitle(runes[0])
+	return string(runes)
+}
+
+// isPluralInitialism reports whether word is a known initialism followed by a lowercase s, e.g. IDs or urls
+func isPluralInitialism(word string, initialisms map[string]bool) bool {
+	if len(word) < 3 || word[len(word)-1] != 's' {
+		return false
+	}
+	return initialisms[strings.ToUpper(word[:len(word)-1])]
+}
+
+// splitWords splits s at separators and case changes as described by ConvertCase
+func splitWords(s string, initialisms map[string]bool) []string {
+	var words []string
+	runes := []rune(s)
+	start := -1
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			if start >= 0 {
+				words = append(words, string(runes[start:i]))
+				start = -1
+			}
+			continue
+		}
+		if start >= 0 && isWordStart(runes, start, i, initialisms) {
+			words = append(words, string(runes[start:i]))
+			start = -1
+		}
+		if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		words = append(words, string(runes[start:]))
+	}
+	return words
+}
+
+// isWordStart reports whether the uppercase letter at i starts a new word in the word beginning at start
+func isWordStart(runes []rune, start, i int, initialisms map[string]bool) bool {
+	if !unicode.IsUpper(runes[i]) {
+		return false
+	}
+	prev := runes[i-1]
+	if !unicode.IsUpper(prev) {
+		return true
+	}
+	if i+1 >= len(runes) || !unicode.IsLower(runes[i+1]) {
+		return false
+	}
+	// Keep a plural initialism such as IDs together instead of splitting it into I and Ds
+	plural := runes[i+1] == 's' && (i+2 >= len(runes) || !unicode.IsLower(runes[i+2]))
+	return !plural || !initialisms[strings.ToUpper(string(runes[start:i+1]))]
+}
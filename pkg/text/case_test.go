@@ -0,0 +1,146 @@
+package text_test
+
+import (
+	"testing"
+
+	"github.com/shapestone/textsmith/pkg/text"
+)
+
+func TestCaseConversions_WithAcronymIdentifier_SplitsWordsAtAcronymBoundaries(t *testing.T) {
+	// Given
+	input := "HTTPServerID"
+
+	tests := []struct {
+		name     string
+		result   string
+		expected string
+	}{
+		{"CamelCase", text.CamelCase(input), "httpServerID"},
+		{"PascalCase", text.PascalCase(input), "HTTPServerID"},
+		{"SnakeCase", text.SnakeCase(input), "http_server_id"},
+		{"KebabCase", text.KebabCase(input), "http-server-id"},
+		{"ScreamingSnakeCase", text.ScreamingSnakeCase(input), "HTTP_SERVER_ID"},
+		{"TitleCase", text.TitleCase(input), "HTTP Server ID"},
+	}
+
+	// Then
+	for _, tt := range tests {
+		if tt.result != tt.expected {
+			t.Errorf("%s: expected %q, got %q", tt.name, tt.expected, tt.result)
+		}
+	}
+}
+
+func TestPascalCase_WithSeparatedWords_CapitalizesInitialisms(t *testing.T) {
+	// Given
+	cases := map[string]string{
+		"http_server_id":   "HTTPServerID",
+		"json-api-url":     "JSONAPIURL",
+		"  hello--world  ": "HelloWorld",
+		"user ids":         "UserIDs",
+		"utf8_reader":      "UTF8Reader",
+		"http2 server":     "HTTP2Server",
+		"xml.http.request": "XMLHTTPRequest",
+		"":                 "",
+	}
+
+	for input, expected := range cases {
+		// When
+		result := text.PascalCase(input)
+
+		// Then
+		if result != expected {
+			t.Errorf("Expected %q for %q, got %q", expected, input, result)
+		}
+	}
+}
+
+func TestSnakeCase_WithCamelCaseIdentifiers_SplitsWords(t *testing.T) {
+	// Given
+	cases := map[string]string{
+		"userIDs":        "user_ids",
+		"getURLsForID":   "get_urls_for_id",
+		"APIsList":       "apis_list",
+		"Base64URL":      "base64_url",
+		"v2API":          "v2_api",
+		"XMLHttpRequest": "xml_http_request",
+		"already_snake":  "already_snake",
+	}
+
+	for input, expected := range cases {
+		// When
+		result := text.SnakeCase(input)
+
+		// Then
+		if result != expected {
+			t.Errorf("Expected %q for %q, got %q", expected, input, result)
+		}
+	}
+}
+
+func TestCamelCase_WithLeadingInitialism_LowercasesFirstWord(t *testing.T) {
+	// Given
+	cases := map[string]string{
+		"ID":          "id",
+		"URL_path":    "urlPath",
+		"HTTP2Server": "http2Server",
+		"user_id":     "userID",
+	}
+
+	for input, expected := range cases {
+		// When
+		result := text.CamelCase(input)
+
+		// Then
+		if result != expected {
+			t.Errorf("Expected %q for %q, got %q", expected, input, result)
+		}
+	}
+}
+
+func TestCaseConversions_WithUnicodeLetters_UsesUnicodeCaseMapping(t *testing.T) {
+	// Given
+	tests := []struct {
+		name     string
+		result   string
+		expected string
+	}{
+		{"SnakeCase", text.SnakeCase("ÉcoleNormale"), "école_normale"},
+		{"PascalCase", text.PascalCase("straße_größe"), "StraßeGröße"},
+		{"ScreamingSnakeCase", text.ScreamingSnakeCase("Ωmega"), "ΩMEGA"},
+		{"TitleCase", text.TitleCase("ǆemal_test"), "ǅemal Test"},
+		{"KebabCase", text.KebabCase("日本語Text"), "日本語-text"},
+	}
+
+	// Then
+	for _, tt := range tests {
+		if tt.result != tt.expected {
+			t.Errorf("%s: expected %q, got %q", tt.name, tt.expected, tt.result)
+		}
+	}
+}
+
+func TestConvertCase_WithCustomInitialisms_UsesThemInsteadOfDefaults(t *testing.T) {
+	// Given
+	opts := text.CaseOptions{Initialisms: []string{"k8s", "Api"}}
+
+	// When
+	result := text.ConvertCase("k8s_api_id", text.CasePascal, opts)
+
+	// Then
+	expected := "K8SAPIId"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestConvertCase_WithNoInitialisms_CapitalizesEveryWordAlike(t *testing.T) {
+	// When
+	result := text.ConvertCase("HTTPServerID", text.CasePascal, text.CaseOptions{NoInitialisms: true})
+
+	// Then
+	expected := "HttpServerId"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
@@ -0,0 +1,191 @@
+package text
+
+import (
+	"regexp"
+	"strings"
+)
+
+// DefaultCommentPrefixes mark the comment lines AlignColumns keeps unchanged when no prefixes are configured
+var DefaultCommentPrefixes = []string{"#", "//"}
+
+// ColumnAlignOptions configures AlignColumnsWithOptions
+type ColumnAlignOptions struct {
+	// Delimiter splits lines into fields, runs of spaces and tabs when empty
+	Delimiter string
+	// Pattern splits lines into fields at its matches and takes precedence over Delimiter
+	Pattern *regexp.Regexp
+	// Separator joins the aligned fields. When empty, a Delimiter is written with a space on each side, e.g.
+	// " = ", and fields split at whitespace or a Pattern are joined with two spaces.
+	Separator string
+	// Align sets the alignment of each column, AlignLeft for columns without an entry
+	Align []Alignment
+	// MaxSplits is the maximum number of times a line is split, so the last field keeps any further delimiters,
+	// no limit when zero
+	MaxSplits int
+	// CommentPrefixes mark lines that are kept unchanged, DefaultCommentPrefixes when nil
+	CommentPrefixes []string
+	// NoComments treats every line as a row
+	NoComments bool
+}
+
+// separator returns the configured separator or the default for the delimiter
+func (o ColumnAlignOptions) separator() string {
+	if o.Separator != "" {
+		return o.Separator
+	}
+	if o.Pattern == nil && strings.TrimSpace(o.Delimiter) != "" {
+		return " " + strings.TrimSpace(o.Delimiter) + " "
+	}
+	return "  "
+}
+
+// limit returns the maximum number of fields per line, or -1 without a limit
+func (o ColumnAlignOptions) limit() int {
+	if o.MaxSplits <= 0 {
+		return -1
+	}
+	return o.MaxSplits + 1
+}
+
+// isComment reports whether a line is a comment line that is kept unchanged
+func (o ColumnAlignOptions) isComment(line string) bool {
+	if o.NoComments {
+		return false
+	}
+	prefixes := o.CommentPrefixes
+	if prefixes == nil {
+		prefixes = DefaultCommentPrefixes
+	}
+	trimmed := strings.TrimLeft(line, " \t")
+	for _, prefix := range prefixes {
+		if prefix != "" && strings.HasPrefix(trimmed, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// AlignColumns splits every line at runs of spaces and tabs and aligns the fields into columns separated by two
+// spaces, like column -t. Fields are measured in display width, and leading indentation is removed; use Indent
+// to indent the result. Blank lines and comment lines starting with # or // are kept unchanged and do not
+// affect the column widths.
+//
+// Code example:
+//
+//	text.AlignColumns("name age city\nAlice 30 Paris\nBob 4 Rome")
+//	// name   age  city
+//	// Alice  30   Paris
+//	// Bob    4    Rome
+func AlignColumns(s string) string {
+	return AlignColumnsWithOptions(s, ColumnAlignOptions{})
+}
+
+// AlignColumnsWithOptions works like AlignColumns with a configurable delimiter or pattern, separator, alignment
+// per column, maximum number of splits and comment prefixes. With a Delimiter or Pattern, fields are trimmed of
+// surrounding whitespace, and lines without a delimiter are kept unchanged and do not affect the column widths.
+// Line endings are kept.
+//
+// Code example:
+//
+//	text.AlignColumnsWithOptions("server.host = localhost\nserver.port = 8080\ndb.url = postgres://db?a=b",
+//		text.ColumnAlignOptions{Delimiter: "=", MaxSplits: 1})
+//	// server.host = localhost
+//	// server.port = 8080
+//	// db.url      = postgres://db?a=b
+func AlignColumnsWithOptions(s string, opts ColumnAlignOptions) string {
+	if s == "" {
+		return ""
+	}
+
+	lines := splitSourceLines(s)
+	rows := make([][]string, len(lines))
+	var widths []int
+	for i, line := range lines {
+		if isBlankLine(line.text) || opts.isComment(line.text) {
+			continue
+		}
+		fields := splitColumnFields(line.text, opts)
+		if len(fields) < 2 && (opts.Delimiter != "" || opts.Pattern != nil) {
+			continue
+		}
+		rows[i] = fields
+		for c, field := range fields {
+			if c == len(widths) {
+				widths = append(widths, 0)
+			}
+			if width := DisplayWidth(field); width > widths[c] {
+				widths[c] = width
+			}
+		}
+	}
+
+	separator := opts.separator()
+	var sb strings.Builder
+	sb.Grow(len(s))
+	for i, line := range lines {
+		if rows[i] == nil {
+			sb.WriteString(line.text)
+			sb.WriteString(line.ending)
+			continue
+		}
+		for c, field := range rows[i] {
+			if c > 0 {
+				sb.WriteString(separator)
+			}
+			switch columnOption(opts.Align, c) {
+			case AlignRight:
+				sb.WriteString(PadLeft(field, widths[c]))
+			case AlignCenter:
+				field = PadCenter(field, widths[c])
+				if c == len(rows[i])-1 {
+					field = strings.TrimRight(field, " ")
+				}
+				sb.WriteString(field)
+			default:
+				if c < len(rows[i])-1 {
+					field = PadRight(field, widths[c])
+				}
+				sb.WriteString(field)
+			}
+		}
+		sb.WriteString(line.ending)
+	}
+	return sb.String()
+}
+
+// splitColumnFields splits a line into trimmed fields as configured by opts
+func splitColumnFields(line string, opts ColumnAlignOptions) []string {
+	limit := opts.limit()
+	line = strings.Trim(line, " \t")
+	var fields []string
+	switch {
+	case opts.Pattern != nil:
+		fields = opts.Pattern.Split(line, limit)
+	case opts.Delimiter != "":
+		fields = strings.SplitN(line, opts.Delimiter, limit)
+	default:
+		return splitWhitespaceFields(line, limit)
+	}
+	for i, field := range fields {
+		fields[i] = strings.TrimSpace(field)
+	}
+	return fields
+}
+
+// splitWhitespaceFields splits a trimmed line at runs of spaces and tabs into at most limit fields, or any
+// number of fields when limit is negative
+func splitWhitespaceFields(line string, limit int) []string {
+	var fields []string
+	for line != "" {
+		if len(fields) == limit-1 {
+			return append(fields, line)
+		}
+		end := strings.IndexAny(line, " \t")
+		if end < 0 {
+			return append(fields, line)
+		}
+		fields = append(fields, line[:end])
+		line = strings.TrimLeft(line[end:], " \t")
+	}
+	return fields
+}
@@ -0,0 +1,138 @@
+package text_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/shapestone/textsmith/pkg/text"
+)
+
+func TestAlignColumns_WithWhitespaceSeparatedFields_AlignsLikeColumnT(t *testing.T) {
+	// Given
+	input := text.StripMargin(`
+		|name	age city
+		|Alice    30   Paris
+		|Bob 4 Rome`)
+
+	// When
+	result := text.AlignColumns(input)
+
+	// Then
+	expected := text.StripMargin(`
+		|name   age  city
+		|Alice  30   Paris
+		|Bob    4    Rome`)
+	if diff, ok := text.Diff(expected, result); !ok {
+		t.Fatalf("Output mismatch:\n%s", diff)
+	}
+}
+
+func TestAlignColumns_WithCommentsBlankLinesAndRaggedRows_KeepsThemUnchanged(t *testing.T) {
+	// Given
+	input := "# hosts\r\n  web 10.0.0.1 primary\r\n\r\ndatabase 10.0.0.25\r\n// end\r\n"
+
+	// When
+	result := text.AlignColumns(input)
+
+	// Then
+	expected := "# hosts\r\nweb       10.0.0.1   primary\r\n\r\ndatabase  10.0.0.25\r\n// end\r\n"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestAlignColumns_WithWideCharacters_AlignsByDisplayWidth(t *testing.T) {
+	// Given
+	input := "世界 world\nhi there"
+
+	// When
+	result := text.AlignColumns(input)
+
+	// Then
+	expected := "世界  world\nhi    there"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestAlignColumnsWithOptions_WithEqualsDelimiterAndMaxSplits_AlignsKeyValuePairs(t *testing.T) {
+	// Given
+	input := text.StripMargin(`
+		|[server]
+		|server.host=localhost
+		|server.port = 8080
+		|# database
+		|db.url =  postgres://localhost/mydb?sslmode=disable`)
+
+	// When
+	result := text.AlignColumnsWithOptions(input, text.ColumnAlignOptions{Delimiter: "=", MaxSplits: 1})
+
+	// Then
+	expected := text.StripMargin(`
+		|[server]
+		|server.host = localhost
+		|server.port = 8080
+		|# database
+		|db.url      = postgres://localhost/mydb?sslmode=disable`)
+	if diff, ok := text.Diff(expected, result); !ok {
+		t.Fatalf("Output mismatch:\n%s", diff)
+	}
+}
+
+func TestAlignColumnsWithOptions_WithColonDelimiterAndSeparator_UsesSeparator(t *testing.T) {
+	// Given
+	opts := text.ColumnAlignOptions{Delimiter: ":", Separator: ": ", MaxSplits: 1}
+
+	// When
+	result := text.AlignColumnsWithOptions("name: textsmith\nversion: 1.2.0\nurl: https://example.com", opts)
+
+	// Then
+	expected := "name   : textsmith\nversion: 1.2.0\nurl    : https://example.com"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestAlignColumnsWithOptions_WithPatternAndAlignment_AlignsEachColumn(t *testing.T) {
+	// Given
+	input := "apples, 3, 0.5\nkiwis,12 ,  12.25\nplums ,100,1"
+	opts := text.ColumnAlignOptions{
+		Pattern:   regexp.MustCompile(`\s*,\s*`),
+		Separator: " | ",
+		Align:     []text.Alignment{text.AlignLeft, text.AlignRight, text.AlignCenter},
+	}
+
+	// When
+	result := text.AlignColumnsWithOptions(input, opts)
+
+	// Then
+	expected := text.StripMargin(`
+		|apples |   3 |  0.5
+		|kiwis  |  12 | 12.25
+		|plums  | 100 |   1`)
+	if diff, ok := text.Diff(expected, result); !ok {
+		t.Fatalf("Output mismatch:\n%s", diff)
+	}
+}
+
+func TestAlignColumnsWithOptions_WithNoComments_AlignsCommentLines(t *testing.T) {
+	// When
+	result := text.AlignColumnsWithOptions("# a\nlong b", text.ColumnAlignOptions{NoComments: true})
+
+	// Then
+	expected := "#     a\nlong  b"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestAlignColumnsWithOptions_WithWhitespaceMaxSplits_KeepsRestInLastField(t *testing.T) {
+	// When
+	result := text.AlignColumnsWithOptions("a1 fix the   bug\nbb22 add tests", text.ColumnAlignOptions{MaxSplits: 1})
+
+	// Then
+	expected := "a1    fix the   bug\nbb22  add tests"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
@@ -0,0 +1,102 @@
+package text
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// graphemeLen returns the byte length of the first extended grapheme cluster of s, following the rules of UAX #29
+// for the characters text cares about: CR LF, combining marks and spacing marks, Hangul syllables, emoji modifier
+// and zero width joiner sequences, and regional indicator pairs.
+func graphemeLen(s string) int {
+	if s == "" {
+		return 0
+	}
+	first, size := utf8.DecodeRuneInString(s)
+	if first == '\r' && len(s) > 1 && s[1] == '\n' { // GB3
+		return 2
+	}
+	if isGraphemeControl(first) { // GB4
+		return size
+	}
+
+	prev := first
+	pictographic := isPictographic(first) // an emoji sequence is open for GB11
+	regional := isRegionalIndicator(first)
+	i := size
+	for i < len(s) {
+		r, n := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case isGraphemeControl(r): // GB5
+			return i
+		case hangulJoins(prev, r): // GB6 to GB8
+		case isGraphemeExtend(r) || r == 0x200D || unicode.Is(unicode.Mc, r): // GB9 and GB9a
+		case prev == 0x200D && pictographic && isPictographic(r): // GB11
+		case regional && isRegionalIndicator(r): // GB12 and GB13
+			regional, pictographic = false, false
+			prev = r
+			i += n
+			continue
+		default: // GB999
+			return i
+		}
+		if !isGraphemeExtend(r) && r != 0x200D {
+			pictographic = isPictographic(r)
+		}
+		regional = false
+		prev = r
+		i += n
+	}
+	return i
+}
+
+// isGraphemeControl reports whether r always forms a cluster on its own
+func isGraphemeControl(r rune) bool {
+	return r < 0x20 || (r >= 0x7F && r < 0xA0) || r == 0x2028 || r == 0x2029
+}
+
+// isGraphemeExtend reports whether r extends the preceding cluster: combining marks, emoji modifiers, tag
+// characters and the zero width non-joiner
+func isGraphemeExtend(r rune) bool {
+	return unicode.In(r, unicode.Mn, unicode.Me) || (r >= 0x1F3FB && r <= 0x1F3FF) ||
+		(r >= 0xE0020 && r <= 0xE007F) || r == 0x200C
+}
+
+// isPictographic approximates the Extended_Pictographic property
+func isPictographic(r rune) bool {
+	switch {
+	case r == 0x00A9 || r == 0x00AE || r == 0x203C || r == 0x2049 || r == 0x2122 || r == 0x2139:
+		return true
+	case r >= 0x2190 && r <= 0x21FF, r >= 0x2300 && r <= 0x23FF, r >= 0x25A0 && r <= 0x27BF,
+		r >= 0x2900 && r <= 0x297F, r >= 0x2B00 && r <= 0x2BFF:
+		return true
+	case r >= 0x1F000 && r <= 0x1FAFF && !isRegionalIndicator(r) && !(r >= 0x1F3FB && r <= 0x1F3FF):
+		return true
+	}
+	return false
+}
+
+// isRegionalIndicator reports whether r is one of the letters used in pairs for flags
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1F1E6 && r <= 0x1F1FF
+}
+
+// hangulJoins reports whether Hangul jamo or syllable r continues the syllable ending with prev
+func hangulJoins(prev, r rune) bool {
+	leading := func(r rune) bool { return (r >= 0x1100 && r <= 0x115F) || (r >= 0xA960 && r <= 0xA97C) }
+	vowel := func(r rune) bool { return (r >= 0x1160 && r <= 0x11A7) || (r >= 0xD7B0 && r <= 0xD7C6) }
+	trailing := func(r rune) bool { return (r >= 0x11A8 && r <= 0x11FF) || (r >= 0xD7CB && r <= 0xD7FB) }
+	syllable := r >= 0xAC00 && r <= 0xD7A3
+	prevLV := prev >= 0xAC00 && prev <= 0xD7A3 && (prev-0xAC00)%28 == 0
+	prevLVT := prev >= 0xAC00 && prev <= 0xD7A3 && !prevLV
+
+	switch {
+	case leading(prev):
+		return leading(r) || vowel(r) || syllable
+	case prevLV || vowel(prev):
+		return vowel(r) || trailing(r)
+	case prevLVT || trailing(prev):
+		return trailing(r)
+	}
+	return false
+}
@@ -0,0 +1,95 @@
+package text
+
+import "strings"
+
+// Indent adds prefix to the start of every line, including blank lines. Line endings are kept as they are, and
+// the empty text after a trailing line ending is not indented.
+//
+// Code example:
+//
+//	text.Indent("if ok {\n\treturn\n}", "\t") // "\tif ok {\n\t\treturn\n\t}"
+func Indent(s string, prefix string) string {
+	return IndentWithOptions(s, prefix, IndentOptions{})
+}
+
+// IndentWithOptions works like Indent and can leave blank lines and the first line unchanged
+//
+// Code example:
+//
+//	text.IndentWithOptions("a:\n\n  b: 1\n", "  ", text.IndentOptions{SkipBlankLines: true}) // "  a:\n\n    b: 1\n"
+func IndentWithOptions(s string, prefix string, opts IndentOptions) string {
+	return mapIndentLines(s, opts, func(line string) string {
+		return prefix + line
+	})
+}
+
+// Outdent removes up to n columns of leading spaces and tabs from every line. A tab advances to the next tab
+// stop; a tab that crosses column n is replaced by the spaces needed to reach its tab stop, so the relative
+// indentation is kept. Lines with less indentation lose all of it.
+//
+// Code example:
+//
+//	text.Outdent("        one\n      two", 4) // "    one\n  two"
+func Outdent(s string, n int) string {
+	return OutdentWithOptions(s, n, IndentOptions{})
+}
+
+// OutdentWithOptions works like Outdent with a configurable tab width, and can leave blank lines and the first
+// line unchanged
+func OutdentWithOptions(s string, n int, opts IndentOptions) string {
+	if n <= 0 {
+		return s
+	}
+	tabWidth := opts.tabWidth()
+	return mapIndentLines(s, opts, func(line string) string {
+		return cutIndent(line, n, tabWidth)
+	})
+}
+
+// Reindent changes the indentation unit while keeping the structure: every levelWidth columns of leading
+// indentation become one unit. Tabs are expanded to the next tab stop before counting, and columns left over
+// after the last full level, e.g. alignment spaces, are kept as spaces.
+//
+// Code example:
+//
+//	text.Reindent("a:\n    b:\n        c: 1", 4, "  ") // "a:\n  b:\n    c: 1"
+//	text.Reindent("if ok {\n\treturn\n}", 4, "    ")   // tabs to four spaces
+func Reindent(s string, levelWidth int, unit string) string {
+	return ReindentWithOptions(s, levelWidth, unit, IndentOptions{})
+}
+
+// ReindentWithOptions works like Reindent with a configurable tab width, and can leave blank lines and the first
+// line unchanged
+func ReindentWithOptions(s string, levelWidth int, unit string, opts IndentOptions) string {
+	if levelWidth <= 0 {
+		return s
+	}
+	tabWidth := opts.tabWidth()
+	return mapIndentLines(s, opts, func(line string) string {
+		width := indentWidth(line, tabWidth)
+		content := strings.TrimLeft(line, " \t")
+		return strings.Repeat(unit, width/levelWidth) + strings.Repeat(" ", width%levelWidth) + content
+	})
+}
+
+// mapIndentLines applies f to the text of every line except those skipped by opts, keeping the line endings
+func mapIndentLines(s string, opts IndentOptions, f func(line string) string) string {
+	if s == "" {
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.Grow(len(s))
+	lines := splitSourceLines(s)
+	for i, line := range lines {
+		text := line.text
+		trailing := i == len(lines)-1 && i > 0 && text == ""
+		skip := trailing || (i == 0 && opts.SkipFirstLine) || (opts.SkipBlankLines && isBlankLine(text))
+		if !skip {
+			text = f(text)
+		}
+		sb.WriteString(text)
+		sb.WriteString(line.ending)
+	}
+	return sb.String()
+}
@@ -0,0 +1,185 @@
+package text_test
+
+import (
+	"testing"
+
+	"github.com/shapestone/textsmith/pkg/text"
+)
+
+func TestIndent_WithMultipleLines_PrefixesEveryLine(t *testing.T) {
+	// Given
+	input := "func main() {\n\tfmt.Println(\"hi\")\n\n}"
+
+	// When
+	result := text.Indent(input, "  ")
+
+	// Then
+	expected := "  func main() {\n  \tfmt.Println(\"hi\")\n  \n  }"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestIndent_WithLineEndings_KeepsThemAndSkipsTrailingEmptyLine(t *testing.T) {
+	// Given
+	input := "one\r\ntwo\nthree\r\n"
+
+	// When
+	result := text.Indent(input, "> ")
+
+	// Then
+	expected := "> one\r\n> two\n> three\r\n"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestIndentWithOptions_WithSkipBlankLines_LeavesBlankLinesUnchanged(t *testing.T) {
+	// Given
+	input := "a:\n\n  b: 1\n \t\nc: 2"
+
+	// When
+	result := text.IndentWithOptions(input, "    ", text.IndentOptions{SkipBlankLines: true})
+
+	// Then
+	expected := "    a:\n\n      b: 1\n \t\n    c: 2"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestIndentWithOptions_WithSkipFirstLine_IndentsContinuationLines(t *testing.T) {
+	// Given
+	value := "{\n  \"id\": 1\n}"
+
+	// When
+	result := "body: " + text.IndentWithOptions(value, "      ", text.IndentOptions{SkipFirstLine: true})
+
+	// Then
+	expected := "body: {\n        \"id\": 1\n      }"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestIndent_WithEmptyString_ReturnsEmptyString(t *testing.T) {
+	// When
+	result := text.Indent("", "  ")
+
+	// Then
+	if result != "" {
+		t.Fatalf("Expected empty string, got %q", result)
+	}
+}
+
+func TestOutdent_WithSpaces_RemovesUpToNColumns(t *testing.T) {
+	// Given
+	input := "        one\n      two\n  three\nfour"
+
+	// When
+	result := text.Outdent(input, 4)
+
+	// Then
+	expected := "    one\n  two\nthree\nfour"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestOutdent_WithCutInsideTab_KeepsColumnsWithSpaces(t *testing.T) {
+	// Given
+	input := "\tone\n  \ttwo\n\t\tthree"
+
+	// When
+	result := text.Outdent(input, 2)
+
+	// Then
+	expected := "  one\n  two\n      three"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestOutdentWithOptions_WithTabWidthAndCRLF_ExpandsTabsAndKeepsLineEndings(t *testing.T) {
+	// Given
+	input := "\t\tone\r\n\t    two\r\n"
+
+	// When
+	result := text.OutdentWithOptions(input, 8, text.IndentOptions{TabWidth: 8})
+
+	// Then
+	expected := "\tone\r\n    two\r\n"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestOutdentWithOptions_WithSkipFirstLine_KeepsSnippetStart(t *testing.T) {
+	// Given
+	snippet := "func() {\n\t\t\treturn nil\n\t\t}"
+
+	// When
+	result := text.OutdentWithOptions(snippet, 8, text.IndentOptions{SkipFirstLine: true})
+
+	// Then
+	expected := "func() {\n\treturn nil\n}"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestReindent_WithFourSpaces_ConvertsToTwoSpaces(t *testing.T) {
+	// Given
+	input := "server:\n    host: localhost\n    tls:\n        enabled: true\n"
+
+	// When
+	result := text.Reindent(input, 4, "  ")
+
+	// Then
+	expected := "server:\n  host: localhost\n  tls:\n    enabled: true\n"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestReindent_WithTabs_ConvertsToSpaces(t *testing.T) {
+	// Given
+	input := "if ok {\n\tfor {\n\t\tbreak\n\t}\n}"
+
+	// When
+	result := text.Reindent(input, 4, "    ")
+
+	// Then
+	expected := "if ok {\n    for {\n        break\n    }\n}"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestReindent_WithSpaces_ConvertsToTabsAndKeepsAlignment(t *testing.T) {
+	// Given
+	input := "call(a,\n     b)\n    x := 1"
+
+	// When
+	result := text.Reindent(input, 4, "\t")
+
+	// Then
+	expected := "call(a,\n\t b)\n\tx := 1"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestReindentWithOptions_WithSkipFirstLineAndBlankLines_LeavesThemUnchanged(t *testing.T) {
+	// Given
+	input := "    first\n        second\n    \n    third"
+
+	// When
+	result := text.ReindentWithOptions(input, 4, " ", text.IndentOptions{SkipFirstLine: true, SkipBlankLines: true})
+
+	// Then
+	expected := "    first\n  second\n    \n third"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
@@ -0,0 +1,97 @@
+package text
+
+import (
+	"strings"
+)
+
+// The Interpolate function replaces ${name} placeholders with the named values. When a value spans several lines,
+// every line after the first is indented to the column where the placeholder appears, so generated code blocks
+// can be nested into templates. Continuation lines reuse the whitespace before the placeholder verbatim and pad
+// any further text before it with spaces; empty lines of a value are not indented.
+//
+// Placeholders without a value are left unchanged, and $${name} produces a literal ${name}.
+//
+// Code example:
+//
+//	body := "if err != nil {\n\treturn err\n}"
+//	text.Interpolate(text.StripMargin(`
+//	|func run() error {
+//	|	${body}
+//	|	return nil
+//	|}`), map[string]string{"body": body})
+func Interpolate(template string, values map[string]string) string {
+	var sb strings.Builder
+	sb.Grow(len(template))
+
+	lineStart := 0
+	for i := 0; i < len(template); {
+		switch {
+		case template[i] == '\n':
+			sb.WriteByte('\n')
+			i++
+			lineStart = i
+			continue
+		case strings.HasPrefix(template[i:], "$${"):
+			if name, ok := placeholderName(template[i+1:]); ok {
+				sb.WriteString("${" + name + "}")
+				i += len("$${}") + len(name)
+				continue
+			}
+		case strings.HasPrefix(template[i:], "${"):
+			if name, ok := placeholderName(template[i:]); ok {
+				if value, found := values[name]; found {
+					sb.WriteString(indentContinuation(value, continuationIndent(template[lineStart:i])))
+					i += len("${}") + len(name)
+					continue
+				}
+			}
+		}
+		sb.WriteByte(template[i])
+		i++
+	}
+
+	return sb.String()
+}
+
+// InterpolateMargin strips the margin of a template with StripMargin and then interpolates the values
+func InterpolateMargin(template string, values map[string]string) string {
+	return Interpolate(StripMargin(template), values)
+}
+
+// placeholderName returns the name of the ${name} placeholder at the start of s
+func placeholderName(s string) (string, bool) {
+	end := strings.IndexByte(s, '}')
+	if !strings.HasPrefix(s, "${") || end < 0 {
+		return "", false
+	}
+	name := s[len("${"):end]
+	if name == "" || strings.ContainsAny(name, " \t\r\n${") {
+		return "", false
+	}
+	return name, true
+}
+
+// continuationIndent returns the indentation that lines up with the column after prefix. Tabs are kept as tabs,
+// so they reach the same tab stops, and the text between them is replaced by spaces of the same display width.
+func continuationIndent(prefix string) string {
+	segments := strings.Split(prefix, "\t")
+	for i, segment := range segments {
+		segments[i] = strings.Repeat(" ", DisplayWidth(segment))
+	}
+	return strings.Join(segments, "\t")
+}
+
+// indentContinuation prefixes every non-empty line of value except the first with indent
+func indentContinuation(value, indent string) string {
+	if indent == "" || !strings.Contains(value, "\n") {
+		return value
+	}
+
+	lines := strings.Split(value, "\n")
+	for i := 1; i < len(lines); i++ {
+		if lines[i] != "" && lines[i] != "\r" {
+			lines[i] = indent + lines[i]
+		}
+	}
+	return strings.Join(lines, "\n")
+}
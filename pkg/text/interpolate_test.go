@@ -0,0 +1,169 @@
+package text_test
+
+import (
+	"testing"
+
+	"github.com/shapestone/textsmith/pkg/text"
+)
+
+func TestInterpolate_WithSingleLineValue_ReplacesPlaceholder(t *testing.T) {
+	// Given
+	template := "Hello, ${name}!"
+
+	// When
+	result := text.Interpolate(template, map[string]string{"name": "World"})
+
+	// Then
+	expected := "Hello, World!"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestInterpolate_WithMultilineValueAfterIndentation_IndentsEveryLine(t *testing.T) {
+	// Given
+	body := text.StripMargin(`
+		|if err != nil {
+		|	return err
+		|}`)
+	template := text.StripMargin(`
+		|func run() error {
+		|	${body}
+		|	return nil
+		|}`)
+
+	// When
+	result := text.Interpolate(template, map[string]string{"body": body})
+
+	// Then
+	expected := text.StripMargin(`
+		|func run() error {
+		|	if err != nil {
+		|		return err
+		|	}
+		|	return nil
+		|}`)
+	if result != expected {
+		diff, _ := text.Diff(expected, result)
+		t.Fatalf("Interpolated output does not match expected:\n\n%s", diff)
+	}
+}
+
+func TestInterpolate_WithPlaceholderAfterText_AlignsToPlaceholderColumn(t *testing.T) {
+	// Given
+	template := "  value: ${list}"
+	list := "- a\n- b\n- c"
+
+	// When
+	result := text.Interpolate(template, map[string]string{"list": list})
+
+	// Then
+	expected := "  value: - a\n         - b\n         - c"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestInterpolate_WithTabsBeforePlaceholder_KeepsTabsInIndentation(t *testing.T) {
+	// Given
+	template := "\tx\t${list}"
+	list := "- a\n- b"
+
+	// When
+	result := text.Interpolate(template, map[string]string{"list": list})
+
+	// Then
+	expected := "\tx\t- a\n\t \t- b"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestInterpolate_WithEmptyLinesInValue_DoesNotAddTrailingWhitespace(t *testing.T) {
+	// Given
+	template := "    ${block}"
+	block := "first\n\nthird"
+
+	// When
+	result := text.Interpolate(template, map[string]string{"block": block})
+
+	// Then
+	expected := "    first\n\n    third"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestInterpolate_WithMissingValue_LeavesPlaceholderUnchanged(t *testing.T) {
+	// Given
+	template := "echo ${HOME} ${name}"
+
+	// When
+	result := text.Interpolate(template, map[string]string{"name": "x"})
+
+	// Then
+	expected := "echo ${HOME} x"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestInterpolate_WithEscapedPlaceholder_ProducesLiteralPlaceholder(t *testing.T) {
+	// Given
+	template := "literal $${name}, value ${name}"
+
+	// When
+	result := text.Interpolate(template, map[string]string{"name": "x"})
+
+	// Then
+	expected := "literal ${name}, value x"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestInterpolate_WithMalformedPlaceholders_LeavesTextUnchanged(t *testing.T) {
+	// Given
+	template := "${} ${unclosed $ {name} ${a b}"
+
+	// When
+	result := text.Interpolate(template, map[string]string{"name": "x", "a b": "y"})
+
+	// Then
+	if result != template {
+		t.Fatalf("Expected %q, got %q", template, result)
+	}
+}
+
+func TestInterpolate_WithUnicodeBeforePlaceholder_AlignsByCharacter(t *testing.T) {
+	// Given
+	template := "«ß» ${v}"
+
+	// When
+	result := text.Interpolate(template, map[string]string{"v": "1\n2"})
+
+	// Then
+	expected := "«ß» 1\n    2"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestInterpolateMargin_WithNestedTemplates_IndentsAtEachLevel(t *testing.T) {
+	// Given
+	inner := text.InterpolateMargin(`
+		|case ${value}:
+		|    return true`, map[string]string{"value": "1"})
+
+	// When
+	result := text.InterpolateMargin(`
+		|switch x {
+		|    ${cases}
+		|}`, map[string]string{"cases": inner})
+
+	// Then
+	expected := "switch x {\n    case 1:\n        return true\n}"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
@@ -0,0 +1,138 @@
+package text
+
+import (
+	"strconv"
+	"strings"
+)
+
+// DefaultGutterSeparator separates the line number gutter from the line when no separator is configured
+const DefaultGutterSeparator = " | "
+
+// DefaultHighlightMarker marks highlighted lines when no marker is configured
+const DefaultHighlightMarker = ">"
+
+// LineNumberOptions configures NumberLinesWithOptions
+type LineNumberOptions struct {
+	// Start is the number of the first line, 1 when zero or negative
+	Start int
+	// Separator is written between the line number and the line, DefaultGutterSeparator when empty
+	Separator string
+	// HighlightFrom is the number of the first highlighted line, no highlighting when zero
+	HighlightFrom int
+	// HighlightTo is the number of the last highlighted line, HighlightFrom when zero
+	HighlightTo int
+	// Marker is written before the numbers of highlighted lines, DefaultHighlightMarker when empty
+	Marker string
+	// ShowWhitespace replaces spaces, tabs and carriage returns with the visible symbols used by Diff
+	ShowWhitespace bool
+}
+
+// start returns the configured first line number or the default
+func (o LineNumberOptions) start() int {
+	if o.Start <= 0 {
+		return 1
+	}
+	return o.Start
+}
+
+// separator returns the configured separator or the default
+func (o LineNumberOptions) separator() string {
+	if o.Separator == "" {
+		return DefaultGutterSeparator
+	}
+	return o.Separator
+}
+
+// marker returns the configured highlight marker or the default
+func (o LineNumberOptions) marker() string {
+	if o.Marker == "" {
+		return DefaultHighlightMarker
+	}
+	return o.Marker
+}
+
+// highlighted reports whether the line with the given number is in the highlight range
+func (o LineNumberOptions) highlighted(number int) bool {
+	if o.HighlightFrom <= 0 {
+		return false
+	}
+	to := o.HighlightTo
+	if to < o.HighlightFrom {
+		to = o.HighlightFrom
+	}
+	return number >= o.HighlightFrom && number <= to
+}
+
+// NumberLines prefixes every line with its right-aligned line number and DefaultGutterSeparator, for printing
+// code snippets in error messages. Line endings are kept, and the empty text after a trailing line ending is not
+// numbered.
+//
+// Code example:
+//
+//	text.NumberLines("package main\n\nfunc main() {}")
+//	// "1 | package main\n2 |\n3 | func main() {}"
+func NumberLines(s string) string {
+	return NumberLinesWithOptions(s, LineNumberOptions{})
+}
+
+// NumberLinesWithOptions works like NumberLines with a configurable first line number, separator, highlighted
+// line range and whitespace symbols. When a highlight range is set, the marker is written before the numbers of
+// highlighted lines and the other lines are padded to the same width. Trailing whitespace of the gutter is trimmed
+// on empty lines. With ShowWhitespace, carriage returns are shown as symbols and lines end in \n.
+//
+// Code example:
+//
+//	text.NumberLinesWithOptions("a := 1\nb := a +\nc := 3", text.LineNumberOptions{Start: 9, HighlightFrom: 10})
+//	//    9 | a := 1
+//	// > 10 | b := a +
+//	//   11 | c := 3
+func NumberLinesWithOptions(s string, opts LineNumberOptions) string {
+	if s == "" {
+		return ""
+	}
+
+	lines := splitSourceLines(s)
+	if len(lines) > 1 && lines[len(lines)-1].text == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	start := opts.start()
+	numberWidth := len(strconv.Itoa(start + len(lines) - 1))
+	separator := opts.separator()
+	marker := ""
+	if opts.HighlightFrom > 0 {
+		marker = opts.marker()
+	}
+
+	var sb strings.Builder
+	sb.Grow(len(s) + len(lines)*(numberWidth+len(separator)+len(marker)+1))
+	for i, line := range lines {
+		number := start + i
+		var gutter strings.Builder
+		if marker != "" {
+			if opts.highlighted(number) {
+				gutter.WriteString(marker + " ")
+			} else {
+				gutter.WriteString(PadRight("", DisplayWidth(marker)+1))
+			}
+		}
+		gutter.WriteString(PadLeft(strconv.Itoa(number), numberWidth))
+		gutter.WriteString(separator)
+
+		content, ending := line.text, line.ending
+		if opts.ShowWhitespace {
+			content = showWhitespaces(content + strings.TrimSuffix(ending, "\n"))
+			if ending != "" {
+				ending = "\n"
+			}
+		}
+		if content == "" {
+			sb.WriteString(strings.TrimRight(gutter.String(), " \t"))
+		} else {
+			sb.WriteString(gutter.String())
+			sb.WriteString(content)
+		}
+		sb.WriteString(ending)
+	}
+	return sb.String()
+}
@@ -0,0 +1,112 @@
+package text_test
+
+import (
+	"testing"
+
+	"github.com/shapestone/textsmith/pkg/text"
+)
+
+func TestNumberLines_WithMultipleLines_PrefixesRightAlignedNumbers(t *testing.T) {
+	// Given
+	input := text.StripMargin(`
+		|line 1
+		|line 2
+		|line 3
+		|line 4
+		|line 5
+		|line 6
+		|line 7
+		|line 8
+		|line 9
+		|line 10`)
+
+	// When
+	result := text.NumberLines(input)
+
+	// Then
+	expected := text.StripColumn(`
+		| 1 | line 1|
+		| 2 | line 2|
+		| 3 | line 3|
+		| 4 | line 4|
+		| 5 | line 5|
+		| 6 | line 6|
+		| 7 | line 7|
+		| 8 | line 8|
+		| 9 | line 9|
+		|10 | line 10|`)
+	if diff, ok := text.Diff(expected, result); !ok {
+		t.Fatalf("Output mismatch:\n%s", diff)
+	}
+}
+
+func TestNumberLines_WithEmptyLinesAndLineEndings_TrimsGutterAndKeepsEndings(t *testing.T) {
+	// Given
+	input := "package main\r\n\r\nfunc main() {}\r\n"
+
+	// When
+	result := text.NumberLines(input)
+
+	// Then
+	expected := "1 | package main\r\n2 |\r\n3 | func main() {}\r\n"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestNumberLines_WithEmptyString_ReturnsEmptyString(t *testing.T) {
+	// When
+	result := text.NumberLines("")
+
+	// Then
+	if result != "" {
+		t.Fatalf("Expected empty string, got %q", result)
+	}
+}
+
+func TestNumberLinesWithOptions_WithStartAndHighlightRange_MarksSelectedLines(t *testing.T) {
+	// Given
+	input := "a := 1\nb := a +\n    c\nd := 4"
+	opts := text.LineNumberOptions{Start: 98, HighlightFrom: 99, HighlightTo: 100}
+
+	// When
+	result := text.NumberLinesWithOptions(input, opts)
+
+	// Then
+	expected := text.StripMargin(`
+		|   98 | a := 1
+		|>  99 | b := a +
+		|> 100 |     c
+		|  101 | d := 4`)
+	if diff, ok := text.Diff(expected, result); !ok {
+		t.Fatalf("Output mismatch:\n%s", diff)
+	}
+}
+
+func TestNumberLinesWithOptions_WithCustomMarkerAndSeparator_UsesThem(t *testing.T) {
+	// Given
+	opts := text.LineNumberOptions{HighlightFrom: 2, Marker: "→", Separator: ": "}
+
+	// When
+	result := text.NumberLinesWithOptions("one\ntwo\nthree", opts)
+
+	// Then
+	expected := "  1: one\n→ 2: two\n  3: three"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestNumberLinesWithOptions_WithShowWhitespace_ShowsWhitespaceSymbols(t *testing.T) {
+	// Given
+	input := "key:\tvalue \r\nnext\n"
+
+	// When
+	result := text.NumberLinesWithOptions(input, text.LineNumberOptions{ShowWhitespace: true})
+
+	// Then
+	expected := "1 | key:␉value␣␍\n2 | next\n"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
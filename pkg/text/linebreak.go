@@ -0,0 +1,207 @@
+package text
+
+import (
+	"unicode"
+)
+
+// breakClass is a Unicode line breaking class of UAX #14
+type breakClass int
+
+const (
+	classAL  breakClass = iota // alphabetic and default
+	classBA                    // break after
+	classBB                    // break before
+	classB2                    // break on either side, but not between pairs
+	classCB                    // contingent break
+	classCL                    // close punctuation
+	classCM                    // combining mark
+	classCP                    // close parenthesis
+	classEM                    // emoji modifier
+	classEX                    // exclamation and interrogation
+	classGL                    // non-breaking glue
+	classHY                    // hyphen
+	classID                    // ideographic
+	classIN                    // inseparable
+	classIS                    // infix numeric separator
+	classNS                    // nonstarter
+	classNU                    // numeric
+	classOP                    // open punctuation
+	classPO                    // postfix numeric
+	classPR                    // prefix numeric
+	classQU                    // quotation
+	classRI                    // regional indicator
+	classSP                    // space
+	classSY                    // symbol allowing break after
+	classWJ                    // word joiner
+	classZW                    // zero width space
+	classZWJ                   // zero width joiner
+)
+
+// breakClasses maps ASCII and common punctuation to their line breaking class. Characters not listed here are
+// classified by their general category in lineBreakClass.
+var breakClasses = map[rune]breakClass{
+	'\t': classBA, '\v': classBA, '\f': classBA, ' ': classSP,
+	'!': classEX, '"': classQU, '$': classPR, '%': classPO, '\'': classQU, '(': classOP, ')': classCP, '+': classPR,
+	',': classIS, '-': classHY, '.': classIS, '/': classSY, ':': classIS, ';': classIS, '?': classEX, '[': classOP,
+	'\\': classPR, ']': classCP, '{': classOP, '|': classBA, '}': classCL,
+	0x00A0: classGL, 0x00A2: classPO, 0x00A3: classPR, 0x00A4: classPR, 0x00A5: classPR, 0x00AB: classQU,
+	0x00AD: classBA, 0x00B0: classPO, 0x00B1: classPR, 0x00B4: classBB, 0x00BB: classQU, 0x02C8: classBB,
+	0x02CC: classBB, 0x02DF: classBB, 0x034F: classGL, 0x058A: classBA, 0x0F0B: classBA, 0x0F0C: classGL,
+	0x1680: classBA, 0x180E: classGL, 0x2007: classGL, 0x2010: classBA, 0x2011: classGL, 0x2012: classBA,
+	0x2013: classBA, 0x2014: classB2, 0x2024: classIN, 0x2025: classIN, 0x2026: classIN, 0x2028: classBA,
+	0x2029: classBA, 0x202F: classGL, 0x203C: classNS, 0x203D: classNS, 0x2044: classIS, 0x2047: classNS,
+	0x2048: classNS, 0x2049: classNS, 0x205F: classBA, 0x2060: classWJ, 0x2103: classPO, 0x2109: classPO,
+	0x2116: classPR, 0x2212: classPR, 0x2213: classPR, 0x22EF: classIN, 0x3000: classBA, 0x3001: classCL,
+	0x3002: classCL, 0x3005: classNS, 0x301C: classNS, 0x303B: classNS, 0x309B: classNS, 0x309C: classNS,
+	0x309D: classNS, 0x309E: classNS, 0x30A0: classNS, 0x30FB: classNS, 0x30FD: classNS, 0x30FE: classNS,
+	0xFE10: classIS, 0xFE11: classCL, 0xFE12: classCL, 0xFE13: classIS, 0xFE14: classIS, 0xFE15: classEX,
+	0xFE16: classEX, 0xFE19: classIN, 0xFE50: classCL, 0xFE52: classCL, 0xFE69: classPR, 0xFE6A: classPO,
+	0xFEFF: classWJ, 0xFF01: classEX, 0xFF04: classPR, 0xFF05: classPO, 0xFF0C: classCL, 0xFF0E: classCL,
+	0xFF1A: classNS, 0xFF1B: classNS, 0xFF1F: classEX, 0xFF61: classCL, 0xFF64: classCL, 0xFF65: classNS,
+	0xFF9E: classNS, 0xFF9F: classNS, 0xFFE0: classPO, 0xFFE1: classPR, 0xFFE5: classPR, 0xFFE6: classPR,
+	0xFFFC: classCB, 0x200B: classZW, 0x200D: classZWJ,
+}
+
+// lineBreakClass returns the line breaking class of r. Classes that need a dictionary or script-specific
+// tailoring, such as SA for Thai, resolve to AL as UAX #14 allows.
+func lineBreakClass(r rune) breakClass {
+	if c, ok := breakClasses[r]; ok {
+		return c
+	}
+	switch {
+	case r < 0x20 || (r >= 0x7F && r < 0xA0):
+		return classCM
+	case r >= 0x2000 && r <= 0x200A:
+		return classBA
+	case r >= 0x1F1E6 && r <= 0x1F1FF:
+		return classRI
+	case r >= 0x1F3FB && r <= 0x1F3FF:
+		return classEM
+	case r >= 0x1160 && r <= 0x11FF:
+		return classCM
+	case unicode.In(r, unicode.Mn, unicode.Mc, unicode.Me):
+		return classCM
+	case unicode.Is(unicode.Nd, r) && r < 0xFF10:
+		return classNU
+	case unicode.Is(unicode.Ps, r):
+		return classOP
+	case unicode.Is(unicode.Pe, r):
+		return classCL
+	case unicode.In(r, unicode.Pi, unicode.Pf):
+		return classQU
+	case unicode.Is(unicode.Sc, r):
+		return classPR
+	case inRanges(r, wideRanges):
+		return classID
+	}
+	return classAL
+}
+
+// lineBreaks returns the byte offsets in s before which UAX #14 allows a line break. Mandatory breaks are not
+// reported because Wrap splits paragraphs at newlines before breaking lines.
+func lineBreaks(s string) []int {
+	var breaks []int
+	var prev, base breakClass // base is the last class before a run of spaces
+	joined := false           // the previous character was a zero width joiner
+	regional := 0             // the number of consecutive regional indicators before the current character
+	for i, r := range s {
+		c := lineBreakClass(r)
+		if i == 0 {
+			// LB10: a combining mark at the start is treated as a letter
+			if c == classCM || c == classZWJ {
+				c = classAL
+			}
+			prev, base = c, c
+			joined = r == 0x200D
+			if c == classRI {
+				regional = 1
+			}
+			continue
+		}
+
+		// LB9: combining marks and joiners attach to the preceding character
+		if (c == classCM || c == classZWJ) && prev != classSP && prev != classZW {
+			joined = c == classZWJ
+			continue
+		}
+		if c == classCM || c == classZWJ {
+			c = classAL
+		}
+
+		if breakAllowed(prev, base, c, joined, regional) {
+			breaks = append(breaks, i)
+		}
+
+		joined = r == 0x200D
+		if c == classRI {
+			regional++
+		} else {
+			regional = 0
+		}
+		prev = c
+		if c != classSP {
+			base = c
+		}
+	}
+	return breaks
+}
+
+// breakAllowed applies the pair rules LB7 to LB31 of UAX #14 between a character of class prev and one of class
+// c. base is the class before any spaces preceding c, and regional the number of regional indicators before c.
+func breakAllowed(prev, base, c breakClass, joined bool, regional int) bool {
+	switch {
+	case c == classSP || c == classZW: // LB7
+		return false
+	case base == classZW: // LB8
+		return true
+	case joined: // LB8a
+		return false
+	case prev == classWJ || c == classWJ: // LB11
+		return false
+	case prev == classGL: // LB12
+		return false
+	case c == classGL && prev != classSP && prev != classBA && prev != classHY: // LB12a
+		return false
+	case c == classCL || c == classCP || c == classEX || c == classIS || c == classSY: // LB13
+		return false
+	case base == classOP: // LB14
+		return false
+	case base == classQU && c == classOP: // LB15
+		return false
+	case (base == classCL || base == classCP) && c == classNS: // LB16
+		return false
+	case base == classB2 && c == classB2: // LB17
+		return false
+	case prev == classSP: // LB18
+		return true
+	case prev == classQU || c == classQU: // LB19
+		return false
+	case prev == classCB || c == classCB: // LB20
+		return true
+	case c == classBA || c == classHY || c == classNS || prev == classBB: // LB21
+		return false
+	case c == classIN: // LB22
+		return false
+	case (prev == classAL && c == classNU) || (prev == classNU && c == classAL): // LB23
+		return false
+	case (prev == classPR && (c == classID || c == classEM)) || ((prev == classID || prev == classEM) && c == classPO): // LB23a
+		return false
+	case ((prev == classPR || prev == classPO) && c == classAL) || (prev == classAL && (c == classPR || c == classPO)): // LB24
+		return false
+	case (prev == classCL || prev == classCP || prev == classNU) && (c == classPO || c == classPR), // LB25
+		(prev == classPO || prev == classPR) && (c == classOP || c == classNU),
+		(prev == classHY || prev == classIS || prev == classNU || prev == classSY) && c == classNU:
+		return false
+	case prev == classAL && c == classAL: // LB28
+		return false
+	case prev == classIS && c == classAL: // LB29
+		return false
+	case ((prev == classAL || prev == classNU) && c == classOP) || (prev == classCP && (c == classAL || c == classNU)): // LB30
+		return false
+	case prev == classRI && c == classRI && regional%2 == 1: // LB30a
+		return false
+	case prev == classID && c == classEM: // LB30b
+		return false
+	}
+	return true // LB31
+}
@@ -0,0 +1,92 @@
+package text
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// MarginSource returns a Go expression calling StripMargin, or StripColumn when a line ends with whitespace, that
+// evaluates to s. The content lines are indented with indent plus a tab and the closing backtick with indent, so
+// the expression can be pasted into a statement indented with indent. Characters a raw string cannot hold, such as
+// backticks and carriage returns, and other non-printable characters are spliced in as interpreted strings.
+//
+// Code example:
+//
+//	text.MarginSource("name: `x`\nage: 42", "\t")
+//
+// returns
+//
+//	text.StripMargin(`
+//			|name: ` + "`" + `x` + "`" + `
+//			|age: 42
+//		`)
+func MarginSource(s string, indent string) string {
+	lines := strings.Split(s, "\n")
+	column := false
+	for _, line := range lines {
+		if strings.HasSuffix(line, " ") || strings.HasSuffix(line, "\t") {
+			column = true
+			break
+		}
+	}
+
+	var sb strings.Builder
+	if column {
+		sb.WriteString("text.StripColumn(`")
+	} else {
+		sb.WriteString("text.StripMargin(`")
+	}
+	for i, line := range lines {
+		sb.WriteString("\n")
+		sb.WriteString(indent)
+		sb.WriteString("\t|")
+		writeRawContent(&sb, line)
+		if column {
+			sb.WriteString("|")
+		} else if i == len(lines)-1 && strings.HasSuffix(line, "\r") {
+			// A trailing \r followed by the closing newline would be read as a \r\n line ending
+			sb.WriteString("`)")
+			return sb.String()
+		}
+	}
+	sb.WriteString("\n")
+	sb.WriteString(indent)
+	sb.WriteString("`)")
+	return sb.String()
+}
+
+// writeRawContent writes s as the inside of a raw string, splicing runs of characters that cannot or should not
+// appear in a raw string as quoted interpreted strings
+func writeRawContent(sb *strings.Builder, s string) {
+	for s != "" {
+		i := 0
+		for i < len(s) && rawSafe(s[i:]) {
+			_, size := utf8.DecodeRuneInString(s[i:])
+			i += size
+		}
+		sb.WriteString(s[:i])
+		s = s[i:]
+
+		j := 0
+		for j < len(s) && !rawSafe(s[j:]) {
+			_, size := utf8.DecodeRuneInString(s[j:])
+			j += size
+		}
+		if j > 0 {
+			sb.WriteString("` + ")
+			sb.WriteString(strconv.Quote(s[:j]))
+			sb.WriteString(" + `")
+			s = s[j:]
+		}
+	}
+}
+
+// rawSafe reports whether the first character of s can be written verbatim inside a raw string
+func rawSafe(s string) bool {
+	r, size := utf8.DecodeRuneInString(s)
+	if r == utf8.RuneError && size == 1 {
+		return false
+	}
+	return r == '\t' || (r != '`' && strconv.IsPrint(r))
+}
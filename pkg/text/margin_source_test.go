@@ -0,0 +1,101 @@
+package text_test
+
+import (
+	"go/constant"
+	"go/token"
+	"go/types"
+	"strings"
+	"testing"
+
+	"github.com/shapestone/textsmith/pkg/text"
+)
+
+// evalMarginSource evaluates an expression returned by MarginSource
+func evalMarginSource(t *testing.T, source string) string {
+	t.Helper()
+	open, end := strings.Index(source, "("), strings.LastIndex(source, ")")
+	tv, err := types.Eval(token.NewFileSet(), nil, token.NoPos, source[open+1:end])
+	if err != nil {
+		t.Fatalf("Expected a constant string expression, got %v in %s", err, source)
+	}
+	literal := constant.StringVal(tv.Value)
+	if strings.HasPrefix(source, "text.StripColumn(") {
+		return text.StripColumn(literal)
+	}
+	return text.StripMargin(literal)
+}
+
+func TestMarginSource_WithPlainText_ReturnsStripMarginExpression(t *testing.T) {
+	// Given
+	input := "line 1\n\n  line 3"
+
+	// When
+	result := text.MarginSource(input, "\t")
+
+	// Then
+	expected := "text.StripMargin(`\n\t\t|line 1\n\t\t|\n\t\t|  line 3\n\t`)"
+	if result != expected {
+		diff, _ := text.Diff(expected, result)
+		t.Fatalf("Unexpected source:\n%s", diff)
+	}
+}
+
+func TestMarginSource_WithTrailingWhitespace_ReturnsStripColumnExpression(t *testing.T) {
+	// Given
+	input := "name  \nage\t"
+
+	// When
+	result := text.MarginSource(input, "")
+
+	// Then
+	expected := "text.StripColumn(`\n\t|name  |\n\t|age\t|\n`)"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestMarginSource_WithBackticks_SplicesInterpretedStrings(t *testing.T) {
+	// Given
+	input := "name: `x`\nage: 42"
+
+	// When
+	result := text.MarginSource(input, "\t")
+
+	// Then
+	expected := "text.StripMargin(`\n\t\t|name: ` + \"`\" + `x` + \"`\" + `\n\t\t|age: 42\n\t`)"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestMarginSource_WithAnyText_RoundTripsExactly(t *testing.T) {
+	inputs := []string{
+		"",
+		"\n",
+		"single line",
+		"trailing newline\n",
+		"a\n\nb\n\n",
+		"crlf\r\nlines\r\n",
+		"crlf at end\r",
+		"lone \r in line",
+		"trailing space \nand tab\t\n",
+		"trailing space \r\n",
+		"``",
+		"|leading pipe\n|a||\n",
+		"nul \x00 and bom \ufeff",
+		"invalid \xff utf-8",
+		"unicode ✓ 世界 🌍",
+		"  indented\n\tcode",
+	}
+
+	for _, input := range inputs {
+		// When
+		source := text.MarginSource(input, "\t\t")
+		result := evalMarginSource(t, source)
+
+		// Then
+		if result != input {
+			t.Errorf("Expected %q to round-trip, got %q from %s", input, result, source)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package text
+
+import (
+	"strings"
+	"unicode"
+)
+
+// DefaultSlugSeparator joins the words of a slug when no separator is configured
+const DefaultSlugSeparator = "-"
+
+// SlugOptions configures SlugifyWithOptions
+type SlugOptions struct {
+	// Separator joins the words of the slug, DefaultSlugSeparator when empty
+	Separator string
+	// MaxLength is the maximum length of the slug in bytes, cut at a word boundary, no limit when zero
+	MaxLength int
+	// KeepCase keeps the case of letters instead of lowercasing them
+	KeepCase bool
+	// Transliterations replace characters before the built-in table is consulted. Replacements are inserted as
+	// written, so a replacement with surrounding spaces becomes a word of its own and an empty replacement removes
+	// the character without separating words.
+	Transliterations map[rune]string
+}
+
+// separator returns the configured separator or the default
+func (o SlugOptions) separator() string {
+	if o.Separator == "" {
+		return DefaultSlugSeparator
+	}
+	return o.Separator
+}
+
+// slugTransliterations maps lowercase non-ASCII letters to ASCII. Uppercase letters are looked up by their
+// lowercase form. Apostrophes are removed so contractions stay one word.
+var slugTransliterations = func() map[rune]string {
+	table := map[rune]string{
+		'ß': "ss", 'æ': "ae", 'ǣ': "ae", 'ǽ': "ae", 'œ': "oe", 'þ': "th", 'ĳ': "ij", 'ǆ': "dz", 'ǳ': "dz", 'ǉ': "lj",
+		'ǌ': "nj", '\'': "", '’': "",
+
+		// Greek
+		'α': "a", 'β': "v", 'γ': "g", 'δ': "d", 'ε': "e", 'ζ': "z", 'η': "i", 'θ': "th", 'ι': "i", 'κ': "k",
+		'λ': "l", 'μ': "m", 'ν': "n", 'ξ': "x", 'ο': "o", 'π': "p", 'ρ': "r", 'σ': "s", 'ς': "s", 'τ': "t",
+		'υ': "y", 'φ': "f", 'χ': "ch", 'ψ': "ps", 'ω': "o", 'ά': "a", 'έ': "e", 'ή': "i", 'ί': "i", 'ό': "o",
+		'ύ': "y", 'ώ': "o", 'ϊ': "i", 'ϋ': "y", 'ΐ': "i", 'ΰ': "y",
+
+		// Cyrillic
+		'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e", 'ж': "zh", 'з': "z", 'и': "i",
+		'й': "y", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t",
+		'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "", 'ы': "y", 'ь': "",
+		'э': "e", 'ю': "yu", 'я': "ya", 'є': "ye", 'і': "i", 'ї': "yi", 'ґ': "g", 'ў': "u",
+	}
+	// Latin letters with diacritics, strokes and hooks, including Latin Extended Additional such as Vietnamese
+	for ascii, letters := range map[string]string{
+		"a": "àáâãäåāăąǎǟǡǻȁȃȧḁạảấầẩẫậắằẳẵặ", "b": "ḃḅḇƀɓ", "c": "çćĉċčḉ", "d": "ďḋḍḏḑḓðđɗ",
+		"e": "èéêëēĕėęěȅȇȩḕḗḙḛḝẹẻẽếềểễệɇəɛ", "f": "ḟƒ", "g": "ĝğġģǧǵḡǥɠ", "h": "ĥȟḣḥḧḩḫẖħ", "i": "ìíîïĩīĭįǐȉȋḭḯỉịıɨ",
+		"j": "ĵǰɉ", "k": "ķǩḱḳḵƙ", "l": "ĺļľḷḹḻḽŀłƚ", "m": "ḿṁṃ", "n": "ñńņňǹṅṇṉṋŉƞɲ",
+		"o": "òóôõöōŏőơǒǫǭȍȏȫȭȯȱṍṏṑṓọỏốồổỗộớờởỡợøǿɔɵ", "p": "ṕṗƥ", "r": "ŕŗřȑȓṙṛṝṟɍ", "s": "śŝşšșṡṣṥṧṩſẛ",
+		"t": "ţťțṫṭṯṱẗŧƭ", "u": "ùúûüũūŭůűųưǔǖǘǚǜȕȗṳṵṷṹṻụủứừửữựʉ", "v": "ṽṿʋ", "w": "ŵẁẃẅẇẉẘ", "x": "ẋẍ",
+		"y": "ýÿŷȳẏẙỳỵỷỹƴɏ", "z": "źżžẑẓẕƶȥ",
+	} {
+		for _, r := range letters {
+			table[r] = ascii
+		}
+	}
+	return table
+}()
+
+// Slugify turns s into a lowercase URL- and file-name-safe slug of ASCII letters and digits joined by hyphens.
+// Latin letters with diacritics, Greek and Cyrillic are transliterated to ASCII; any other character separates
+// words.
+//
+// Code example:
+//
+//	text.Slugify("Crème Brûlée: a Recipe!")            // "creme-brulee-a-recipe"
+//	text.Slugify("TestRender/wide characters (世界)") // "testrender-wide-characters"
+func Slugify(s string) string {
+	return SlugifyWithOptions(s, SlugOptions{})
+}
+
+// SlugifyWithOptions works like Slugify with a configurable separator, maximum length, case and transliteration
+// table. The maximum length cuts the slug after the last whole word that fits; a first word longer than the
+// maximum is cut at the maximum length.
+//
+// Code example:
+//
+//	text.SlugifyWithOptions("Straße & Größe", text.SlugOptions{
+//		Separator:        "_",
+//		Transliterations: map[rune]string{'&': "and", 'ö': "oe"},
+//	})
+//	// "strasse_and_groesse"
+func SlugifyWithOptions(s string, opts SlugOptions) string {
+	var transliterated strings.Builder
+	transliterated.Grow(len(s))
+	for _, r := range s {
+		if replacement, ok := opts.Transliterations[r]; ok {
+			transliterated.WriteString(replacement)
+			continue
+		}
+		transliterated.WriteString(transliterateRune(r))
+	}
+
+	words := strings.FieldsFunc(transliterated.String(), func(r rune) bool {
+		return !isSlugChar(r)
+	})
+
+	separator := opts.separator()
+	var sb strings.Builder
+	for _, word := range words {
+		if !opts.KeepCase {
+			word = strings.ToLower(word)
+		}
+		if sb.Len() > 0 {
+			if opts.MaxLength > 0 && sb.Len()+len(separator)+len(word) > opts.MaxLength {
+				break
+			}
+			sb.WriteString(separator)
+		} else if opts.MaxLength > 0 && len(word) > opts.MaxLength {
+			word = word[:opts.MaxLength]
+		}
+		sb.WriteString(word)
+	}
+	return sb.String()
+}
+
+// transliterateRune returns the ASCII form of r, or a space when r has none and separates words
+func transliterateRune(r rune) string {
+	if replacement, ok := slugTransliterations[r]; ok {
+		return replacement
+	}
+	if r <= unicode.MaxASCII {
+		return string(r)
+	}
+	lower := unicode.ToLower(r)
+	if lower <= unicode.MaxASCII {
+		return string(lower)
+	}
+	if replacement, ok := slugTransliterations[lower]; ok {
+		if replacement == "" {
+			return ""
+		}
+		// Capitalize the transliteration of an uppercase letter, e.g. Ж to Zh
+		return strings.ToUpper(replacement[:1]) + replacement[1:]
+	}
+	return " "
+}
+
+// isSlugChar reports whether r may appear in a slug
+func isSlugChar(r rune) bool {
+	return r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9'
+}
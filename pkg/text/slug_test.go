@@ -0,0 +1,125 @@
+package text_test
+
+import (
+	"testing"
+
+	"github.com/shapestone/textsmith/pkg/text"
+)
+
+func TestSlugify_WithPunctuationAndDiacritics_ReturnsLowercaseASCIISlug(t *testing.T) {
+	// Given
+	cases := map[string]string{
+		"Crème Brûlée: a Recipe!":         "creme-brulee-a-recipe",
+		"  Hello,   World --  ":           "hello-world",
+		"Straße in Łódź":                  "strasse-in-lodz",
+		"Ærøskøbing Œuvre":                "aeroskobing-oeuvre",
+		"Don't stop":                      "dont-stop",
+		"Version 2.0.1":                   "version-2-0-1",
+		"TestRender/wide characters (世界)": "testrender-wide-characters",
+		"世界":                              "",
+		"":                                "",
+	}
+
+	for input, expected := range cases {
+		// When
+		result := text.Slugify(input)
+
+		// Then
+		if result != expected {
+			t.Errorf("Expected %q for %q, got %q", expected, input, result)
+		}
+	}
+}
+
+func TestSlugify_WithGreekAndCyrillic_Transliterates(t *testing.T) {
+	// Given
+	cases := map[string]string{
+		"Αθήνα":      "athina",
+		"Москва":     "moskva",
+		"Щука и Ёж":  "shchuka-i-ezh",
+		"Львів":      "lviv",
+		"ОБЪЕКТ":     "obekt",
+		"ПОДЪЁМ":     "podem",
+		"объявление": "obyavlenie",
+	}
+
+	for input, expected := range cases {
+		// When
+		result := text.Slugify(input)
+
+		// Then
+		if result != expected {
+			t.Errorf("Expected %q for %q, got %q", expected, input, result)
+		}
+	}
+}
+
+func TestSlugify_WithLatinExtendedLetters_Transliterates(t *testing.T) {
+	// Given
+	cases := map[string]string{
+		"Tiếng Việt":          "tieng-viet",
+		"Phở Hà Nội Đặc Biệt": "pho-ha-noi-dac-biet",
+		"NGUYỄN ỨNG":          "nguyen-ung",
+		"Ḥalab Ṣaḥīḥ":         "halab-sahih",
+		"Ǧǩǰ Ȟȳ":              "gkj-hy",
+		"Azərbaycan Ǆemal":    "azerbaycan-dzemal",
+	}
+
+	for input, expected := range cases {
+		// When
+		result := text.Slugify(input)
+
+		// Then
+		if result != expected {
+			t.Errorf("Expected %q for %q, got %q", expected, input, result)
+		}
+	}
+}
+
+func TestSlugifyWithOptions_WithMaxLength_CutsAtWordBoundary(t *testing.T) {
+	// Given
+	input := "The quick brown fox jumps"
+
+	cases := map[int]string{
+		9:   "the-quick",
+		14:  "the-quick",
+		15:  "the-quick-brown",
+		2:   "th",
+		100: "the-quick-brown-fox-jumps",
+	}
+
+	for maxLength, expected := range cases {
+		// When
+		result := text.SlugifyWithOptions(input, text.SlugOptions{MaxLength: maxLength})
+
+		// Then
+		if result != expected {
+			t.Errorf("Expected %q for max length %d, got %q", expected, maxLength, result)
+		}
+	}
+}
+
+func TestSlugifyWithOptions_WithSeparatorAndKeepCase_KeepsCaseForFileNames(t *testing.T) {
+	// When
+	result := text.SlugifyWithOptions("TestDiff/Ünïcode Лист", text.SlugOptions{Separator: "_", KeepCase: true})
+
+	// Then
+	expected := "TestDiff_Unicode_List"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestSlugifyWithOptions_WithTransliterationTable_OverridesBuiltInTable(t *testing.T) {
+	// Given
+	opts := text.SlugOptions{Transliterations: map[rune]string{'&': " and ", 'ö': "oe", 'ü': "ue", '世': "shi", '界': "jie"}}
+
+	// When
+	result := text.SlugifyWithOptions("Müller&Söhne 世界", opts)
+
+	// Then
+	expected := "mueller-and-soehne-shijie"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
@@ -0,0 +1,114 @@
+package text_test
+
+import (
+	"testing"
+
+	"github.com/shapestone/textsmith/pkg/text"
+)
+
+func TestStripColumn_WithDoubledClosingPipe_KeepsLiteralTrailingPipe(t *testing.T) {
+	// Given
+	input := `
+	|cat file ||
+	|a || b||
+	`
+
+	// When
+	result := text.StripColumn(input)
+
+	// Then
+	expected := "cat file |\na || b|"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestStripColumn_WithOnlyPipeContent_ReturnsPipes(t *testing.T) {
+	// Given
+	input := "|||\n||||"
+
+	// When
+	result := text.StripColumn(input)
+
+	// Then
+	expected := "|\n||"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestStripColumn_WithWhitespaceBeforeClosingPipe_PreservesWhitespace(t *testing.T) {
+	// Given
+	input := "|spaces   |\n|tab\t|\n|nbsp\u00a0|\n|   |"
+
+	// When
+	result := text.StripColumn(input)
+
+	// Then
+	expected := "spaces   \ntab\t\nnbsp\u00a0\n   "
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestStripColumn_WithWhitespaceAfterClosingPipe_IgnoresWhitespace(t *testing.T) {
+	// Given
+	input := "|a|  \t\n|b |\t "
+
+	// When
+	result := text.StripColumn(input)
+
+	// Then
+	expected := "a\nb "
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestStripColumn_WithPipeAndWhitespaceAtEdge_KeepsBoth(t *testing.T) {
+	// Given - content "x |  " ends with a pipe followed by two spaces
+	input := "|x |  |"
+
+	// When
+	result := text.StripColumn(input)
+
+	// Then
+	expected := "x |  "
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestStripColumnStrict_WithDoubledClosingPipe_IsWellFormed(t *testing.T) {
+	// Given
+	input := `
+	|echo a ||
+	|echo b|
+	`
+
+	// When
+	result, err := text.StripColumnStrict(input)
+
+	// Then
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := "echo a |\necho b"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestStripColumnWithOptions_WithDoubledCustomMarker_KeepsLiteralMarker(t *testing.T) {
+	// Given
+	input := "┃a┃┃\n┃b ┃"
+
+	// When
+	result := text.StripColumnWithOptions(input, text.MarginOptions{Marker: "┃"})
+
+	// Then
+	expected := "a┃\nb "
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
@@ -1,12 +1,167 @@
 package text
 
 import (
-	"regexp"
+	"io"
 	"strings"
 )
 
-// Regex for strip margin functionality
-var stripMarginGroup = regexp.MustCompile(`(?m)^[ \t]*\|(.*)(?:\r?\n|$)`)
+// DefaultMarginMarker is the margin marker used by StripMargin and StripColumn
+const DefaultMarginMarker = "|"
+
+// LineEnding selects the line endings written between the lines returned by StripMargin and StripColumn
+type LineEnding int
+
+const (
+	// LineEndingPreserve keeps the line ending that followed each line in the input
+	LineEndingPreserve LineEnding = iota
+	// LineEndingLF separates output lines with \n
+	LineEndingLF
+	// LineEndingCRLF separates output lines with \r\n
+	LineEndingCRLF
+)
+
+// MarginOptions configures StripMarginWithOptions and StripColumnWithOptions
+type MarginOptions struct {
+	// Marker is the margin marker, DefaultMarginMarker when empty. Any string can be used, e.g. ">", "#" or "┃".
+	Marker string
+	// LineEnding is the output line ending policy, LineEndingPreserve by default
+	LineEnding LineEnding
+}
+
+// marker returns the configured margin marker or the default
+func (o MarginOptions) marker() string {
+	if o.Marker == "" {
+		return DefaultMarginMarker
+	}
+	return o.Marker
+}
+
+// sourceLine is an input line split from the line ending that follows it
+type sourceLine struct {
+	text   string
+	ending string
+}
+
+// splitSourceLines splits text into lines, recognizing \n and \r\n as line endings. The last line has an empty
+// ending. A lone \r is content.
+func splitSourceLines(s string) []sourceLine {
+	var lines []sourceLine
+	for {
+		line, rest, more := nextSourceLine(s)
+		lines = append(lines, line)
+		if !more {
+			return lines
+		}
+		s = rest
+	}
+}
+
+// nextSourceLine splits the first line off s and reports whether another line follows
+func nextSourceLine(s string) (line sourceLine, rest string, more bool) {
+	i := strings.IndexByte(s, '\n')
+	if i < 0 {
+		return sourceLine{text: s}, "", false
+	}
+	if i > 0 && s[i-1] == '\r' {
+		return sourceLine{text: s[:i-1], ending: "\r\n"}, s[i+1:], true
+	}
+	return sourceLine{text: s[:i], ending: "\n"}, s[i+1:], true
+}
+
+// separator returns the line ending written after an output line whose input line ended with ending
+func (e LineEnding) separator(ending string) string {
+	switch e {
+	case LineEndingLF:
+		return "\n"
+	case LineEndingCRLF:
+		return "\r\n"
+	}
+	return ending
+}
+
+// marginContent returns the content of a line after optional spaces and tabs and the margin marker
+func marginContent(line, marker string) (string, bool) {
+	i := 0
+	for i < len(line) && (line[i] == ' ' || line[i] == '\t') {
+		i++
+	}
+	if !strings.HasPrefix(line[i:], marker) {
+		return "", false
+	}
+	return line[i+len(marker):], true
+}
+
+// columnContent returns the content between the opening marker and the last marker of a line, which may only be
+// followed by spaces and tabs
+func columnContent(line, marker string) (string, bool) {
+	rest, ok := marginContent(line, marker)
+	if !ok {
+		return "", false
+	}
+	end := len(rest)
+	for end > 0 && (rest[end-1] == ' ' || rest[end-1] == '\t') {
+		end--
+	}
+	if !strings.HasSuffix(rest[:end], marker) {
+		return "", false
+	}
+	return rest[:end-len(marker)], true
+}
+
+// stripTo scans s line by line in a single pass and writes the content of every line accepted by content to w,
+// separated according to the line ending policy
+func stripTo(w io.StringWriter, s string, opts MarginOptions, content func(line, marker string) (string, bool)) (int, error) {
+	marker := opts.marker()
+	written := 0
+	separator := ""
+	for more := s != ""; more; {
+		var line sourceLine
+		line, s, more = nextSourceLine(s)
+
+		c, ok := content(line.text, marker)
+		if !ok {
+			continue
+		}
+		for _, part := range [2]string{separator, c} {
+			if part == "" {
+				continue
+			}
+			n, err := w.WriteString(part)
+			written += n
+			if err != nil {
+				return written, err
+			}
+		}
+		separator = opts.LineEnding.separator(line.ending)
+	}
+	return written, nil
+}
+
+// stripString runs stripTo into a builder sized for the input, since the output is never longer than the input
+// when line endings are preserved
+func stripString(s string, opts MarginOptions, content func(line, marker string) (string, bool)) string {
+	var sb strings.Builder
+	sb.Grow(len(s))
+	_, _ = stripTo(&sb, s, opts, content)
+	return sb.String()
+}
+
+// stringWriter adapts an io.Writer to io.StringWriter
+type stringWriter struct {
+	w io.Writer
+}
+
+func (sw stringWriter) WriteString(s string) (int, error) {
+	return io.WriteString(sw.w, s)
+}
+
+// asStringWriter returns w as an io.StringWriter, avoiding a wrapper when w already is one
+func asStringWriter(w io.Writer) io.StringWriter {
+	if sw, ok := w.(io.StringWriter); ok {
+		return sw
+	}
+	return stringWriter{w: w}
+}
 
 // The StripMargin function lets you define multiline strings where each line is prepended with optional whitespace
 // and a pipeline symbol
@@ -18,36 +173,35 @@ var stripMarginGroup = regexp.MustCompile(`(?m)^[ \t]*\|(.*)(?:\r?\n|$)`)
 //	|<content line 2>
 //	`)
 func StripMargin(s string) string {
-	// Handle an empty string case
-	if s == "" {
-		return ""
-	}
-
-	// Use Unicode-safe string operations
-	lines := strings.Split(s, "\n")
-	var result []string
-
-	for _, line := range lines {
-		// Check if line matches the margin pattern
-		if match := stripMarginGroup.FindStringSubmatch(line + "\n"); match != nil {
-			result = append(result, match[1])
-		}
-	}
-
-	// If no matches found, return empty string
-	if len(result) == 0 {
-		return ""
-	}
+	return StripMarginWithOptions(s, MarginOptions{})
+}
 
-	return strings.Join(result, "\n")
+// StripMarginWithOptions works like StripMargin with a configurable margin marker and line ending policy. Both
+// \n and \r\n are recognized as input line endings and are never part of the returned content.
+//
+// Code example:
+//
+//	text.StripMarginWithOptions(`
+//	> | name | age |
+//	> | ---- | --- |
+//	`, text.MarginOptions{Marker: ">"})
+func StripMarginWithOptions(s string, opts MarginOptions) string {
+	return stripString(s, opts, marginContent)
 }
 
-// Regex for strip column functionality
-var stripColumnGroup = regexp.MustCompile(`(?m)^[ \t]*\|(.*)(?:\|[ \t]*\n|\|[ \t]*$)`)
+// StripMarginTo works like StripMarginWithOptions but writes the result to w, returning the number of bytes
+// written and the first write error
+func StripMarginTo(w io.Writer, s string, opts MarginOptions) (int, error) {
+	return stripTo(asStringWriter(w), s, opts, marginContent)
+}
 
 // The StripColumn function lets you define multiline strings where each line is prepended with optional whitespace
 // and pipeline symbols
 //
+// The closing pipe is always the last pipe on a line, so everything between the first and the last pipe is content.
+// Whitespace before the closing pipe is preserved and whitespace after it is ignored. Content that itself ends with
+// a pipe is written by doubling the pipe at the column edge: |a || b|| yields "a || b|".
+//
 // Code example:
 //
 // text.StripColumn(`
@@ -57,18 +211,24 @@ var stripColumnGroup = regexp.MustCompile(`(?m)^[ \t]*\|(.*)(?:\|[ \t]*\n|\|[ \t
 //
 // `)
 func StripColumn(s string) string {
-	ms := stripColumnGroup.FindAllStringSubmatch(s, -1)
-	if ms == nil {
-		return ``
-	}
+	return StripColumnWithOptions(s, MarginOptions{})
+}
 
-	lines := ``
-	for idx, m := range ms {
-		if idx > 0 {
-			lines += "\n"
-		}
-		lines += m[1]
-	}
+// StripColumnWithOptions works like StripColumn with a configurable marker enclosing each line and line ending
+// policy. Both \n and \r\n are recognized as input line endings and are never part of the returned content.
+//
+// Code example:
+//
+//	text.StripColumnWithOptions(`
+//	┃cat file | grep x ┃
+//	┃sort | uniq       ┃
+//	`, text.MarginOptions{Marker: "┃"})
+func StripColumnWithOptions(s string, opts MarginOptions) string {
+	return stripString(s, opts, columnContent)
+}
 
-	return lines
+// StripColumnTo works like StripColumnWithOptions but writes the result to w, returning the number of bytes
+// written and the first write error
+func StripColumnTo(w io.Writer, s string, opts MarginOptions) (int, error) {
+	return stripTo(asStringWriter(w), s, opts, columnContent)
 }
@@ -0,0 +1,240 @@
+package text
+
+import (
+	"bytes"
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// The StripMargin and StripColumn implementations that the single-pass scanner replaced, copied verbatim so the
+// benchmarks measure the actual before and after
+var stripMarginGroup = regexp.MustCompile(`(?m)^[ \t]*\|(.*)(?:\r?\n|$)`)
+
+func baselineStripMargin(s string) string {
+	// Handle an empty string case
+	if s == "" {
+		return ""
+	}
+
+	// Use Unicode-safe string operations
+	lines := strings.Split(s, "\n")
+	var result []string
+
+	for _, line := range lines {
+		// Check if line matches the margin pattern
+		if match := stripMarginGroup.FindStringSubmatch(line + "\n"); match != nil {
+			result = append(result, match[1])
+		}
+	}
+
+	// If no matches found, return empty string
+	if len(result) == 0 {
+		return ""
+	}
+
+	return strings.Join(result, "\n")
+}
+
+var stripColumnGroup = regexp.MustCompile(`(?m)^[ \t]*\|(.*)(?:\|[ \t]*\n|\|[ \t]*$)`)
+
+func baselineStripColumn(s string) string {
+	ms := stripColumnGroup.FindAllStringSubmatch(s, -1)
+	if ms == nil {
+		return ``
+	}
+
+	lines := ``
+	for idx, m := range ms {
+		if idx > 0 {
+			lines += "\n"
+		}
+		lines += m[1]
+	}
+
+	return lines
+}
+
+// A line-by-line regex reference that keeps CRLF line endings like the scanner does, which the baseline
+// implementations do not, for the equivalence tests
+var (
+	regexMarginGroup = regexp.MustCompile(`^[ \t]*\|(.*)$`)
+	regexColumnGroup = regexp.MustCompile(`^[ \t]*\|(.*)\|[ \t]*$`)
+)
+
+func regexStrip(s string, pattern *regexp.Regexp) string {
+	var result []string
+	var endings []string
+	for _, line := range strings.Split(s, "\n") {
+		ending := "\n"
+		if strings.HasSuffix(line, "\r") {
+			line, ending = line[:len(line)-1], "\r\n"
+		}
+		if match := pattern.FindStringSubmatch(line); match != nil {
+			result = append(result, match[1])
+			endings = append(endings, ending)
+		}
+	}
+
+	var sb strings.Builder
+	for i, r := range result {
+		if i > 0 {
+			sb.WriteString(endings[i-1])
+		}
+		sb.WriteString(r)
+	}
+	return sb.String()
+}
+
+var stripEquivalenceInputs = []string{
+	"",
+	"|",
+	"||",
+	"|||",
+	"\n\t|line 1\n\t|line 2\n\t",
+	"\r\n\t|line 1 |\r\n\t|line 2|  \r\n\t",
+	"|a\r\n|b\n|c\r\n|d",
+	"|a|  \t\n|b |\t \nno margin\n  |open\n|x| y",
+	"|progress\r50%\n|done|",
+	"\t |🚀 space nbsp| \n  |test thinspace|\t",
+	"|a||\n|||\n|\n\n|",
+}
+
+func TestStripMargin_WithReferenceInputs_MatchesRegexImplementation(t *testing.T) {
+	for _, input := range stripEquivalenceInputs {
+		// When
+		result := StripMargin(input)
+
+		// Then
+		if expected := regexStrip(input, regexMarginGroup); result != expected {
+			t.Errorf("StripMargin(%q): expected %q, got %q", input, expected, result)
+		}
+	}
+}
+
+func TestStripColumn_WithReferenceInputs_MatchesRegexImplementation(t *testing.T) {
+	for _, input := range stripEquivalenceInputs {
+		// When
+		result := StripColumn(input)
+
+		// Then
+		if expected := regexStrip(input, regexColumnGroup); result != expected {
+			t.Errorf("StripColumn(%q): expected %q, got %q", input, expected, result)
+		}
+	}
+}
+
+func TestStripMarginTo_WithBuffer_WritesSameContentAsStripMargin(t *testing.T) {
+	// Given
+	input := "\n\t|line 1\n\t|line 2\n\t"
+	var buf bytes.Buffer
+
+	// When
+	n, err := StripMarginTo(&buf, input, MarginOptions{})
+
+	// Then
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if buf.String() != StripMargin(input) || n != buf.Len() {
+		t.Fatalf("Expected %q (%d bytes), got %q (%d bytes)", StripMargin(input), len(StripMargin(input)), buf.String(), n)
+	}
+}
+
+// failingWriter accepts a limited number of bytes and then fails
+type failingWriter struct {
+	limit int
+}
+
+var errWriteFailed = errors.New("write failed")
+
+func (w *failingWriter) Write(p []byte) (int, error) {
+	if len(p) > w.limit {
+		n := w.limit
+		w.limit = 0
+		return n, errWriteFailed
+	}
+	w.limit -= len(p)
+	return len(p), nil
+}
+
+func TestStripColumnTo_WithFailingWriter_ReturnsErrorAndBytesWritten(t *testing.T) {
+	// Given
+	input := "|abc|\n|def|"
+	w := &failingWriter{limit: 5}
+
+	// When
+	n, err := StripColumnTo(w, input, MarginOptions{})
+
+	// Then
+	if !errors.Is(err, errWriteFailed) {
+		t.Fatalf("Expected write error, got %v", err)
+	}
+	if n != 5 {
+		t.Fatalf("Expected 5 bytes written, got %d", n)
+	}
+}
+
+// benchmarkInput builds a margin literal with the given number of lines
+func benchmarkInput(lines int, column bool) string {
+	var sb strings.Builder
+	sb.WriteString("\n")
+	for i := 0; i < lines; i++ {
+		sb.WriteString("\t\t|    fmt.Println(\"Hello, World!\") // line")
+		if column {
+			sb.WriteString("   |")
+		}
+		sb.WriteString("\n")
+	}
+	sb.WriteString("\t")
+	return sb.String()
+}
+
+func BenchmarkStripMargin_Scanner(b *testing.B) {
+	input := benchmarkInput(1000, false)
+	b.ReportAllocs()
+	b.SetBytes(int64(len(input)))
+	for i := 0; i < b.N; i++ {
+		StripMargin(input)
+	}
+}
+
+func BenchmarkStripMargin_Baseline(b *testing.B) {
+	input := benchmarkInput(1000, false)
+	b.ReportAllocs()
+	b.SetBytes(int64(len(input)))
+	for i := 0; i < b.N; i++ {
+		baselineStripMargin(input)
+	}
+}
+
+func BenchmarkStripColumn_Scanner(b *testing.B) {
+	input := benchmarkInput(1000, true)
+	b.ReportAllocs()
+	b.SetBytes(int64(len(input)))
+	for i := 0; i < b.N; i++ {
+		StripColumn(input)
+	}
+}
+
+func BenchmarkStripColumn_Baseline(b *testing.B) {
+	input := benchmarkInput(1000, true)
+	b.ReportAllocs()
+	b.SetBytes(int64(len(input)))
+	for i := 0; i < b.N; i++ {
+		baselineStripColumn(input)
+	}
+}
+
+func BenchmarkStripMarginTo_Buffer(b *testing.B) {
+	input := benchmarkInput(1000, false)
+	var buf bytes.Buffer
+	buf.Grow(len(input))
+	b.ReportAllocs()
+	b.SetBytes(int64(len(input)))
+	for i := 0; i < b.N; i++ {
+		buf.Reset()
+		_, _ = StripMarginTo(&buf, input, MarginOptions{})
+	}
+}
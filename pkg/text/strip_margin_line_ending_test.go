@@ -0,0 +1,146 @@
+package text_test
+
+import (
+	"testing"
+
+	"github.com/shapestone/textsmith/pkg/text"
+)
+
+func TestStripMargin_WithCRLFInput_DoesNotKeepCarriageReturnsInContent(t *testing.T) {
+	// Given
+	input := "\r\n\t|line 1\r\n\t|line 2\r\n\t"
+
+	// When
+	result := text.StripMargin(input)
+
+	// Then
+	expected := "line 1\r\nline 2"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestStripColumn_WithCRLFInput_KeepsEveryLine(t *testing.T) {
+	// Given
+	input := "\r\n\t|line 1 |\r\n\t|line 2|  \r\n\t"
+
+	// When
+	result := text.StripColumn(input)
+
+	// Then
+	expected := "line 1 \r\nline 2"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestStripMarginWithOptions_WithMixedEndingsAndPreserve_KeepsEachLineEnding(t *testing.T) {
+	// Given
+	input := "|a\r\n|b\n|c\r\n|d"
+
+	// When
+	result := text.StripMarginWithOptions(input, text.MarginOptions{LineEnding: text.LineEndingPreserve})
+
+	// Then
+	expected := "a\r\nb\nc\r\nd"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestStripMarginWithOptions_WithMixedEndingsAndLF_ForcesLF(t *testing.T) {
+	// Given
+	input := "|a\r\n|b\n|c\r\n|d"
+
+	// When
+	result := text.StripMarginWithOptions(input, text.MarginOptions{LineEnding: text.LineEndingLF})
+
+	// Then
+	expected := "a\nb\nc\nd"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestStripMarginWithOptions_WithMixedEndingsAndCRLF_ForcesCRLF(t *testing.T) {
+	// Given
+	input := "|a\r\n|b\n|c\r\n|d"
+
+	// When
+	result := text.StripMarginWithOptions(input, text.MarginOptions{LineEnding: text.LineEndingCRLF})
+
+	// Then
+	expected := "a\r\nb\r\nc\r\nd"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestStripColumnWithOptions_WithMixedEndings_FollowsEachPolicy(t *testing.T) {
+	// Given
+	input := "\n  |a |\r\n  |b|\n  |c|\r\n  "
+
+	tests := []struct {
+		policy   text.LineEnding
+		expected string
+	}{
+		{text.LineEndingPreserve, "a \r\nb\nc"},
+		{text.LineEndingLF, "a \nb\nc"},
+		{text.LineEndingCRLF, "a \r\nb\r\nc"},
+	}
+
+	for _, tt := range tests {
+		// When
+		result := text.StripColumnWithOptions(input, text.MarginOptions{LineEnding: tt.policy})
+
+		// Then
+		if result != tt.expected {
+			t.Errorf("Policy %d: expected %q, got %q", tt.policy, tt.expected, result)
+		}
+	}
+}
+
+func TestStripMarginWithOptions_WithSkippedLines_UsesEndingOfKeptLine(t *testing.T) {
+	// Given - the line without margin is dropped, the first kept line ended with \r\n
+	input := "|a\r\nno margin\n|b"
+
+	// When
+	result := text.StripMarginWithOptions(input, text.MarginOptions{})
+
+	// Then
+	expected := "a\r\nb"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestStripMargin_WithLoneCarriageReturn_KeepsItAsContent(t *testing.T) {
+	// Given
+	input := "|progress\r50%\n|done"
+
+	// When
+	result := text.StripMargin(input)
+
+	// Then
+	expected := "progress\r50%\ndone"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestStripColumnStrict_WithCRLFInput_AcceptsLines(t *testing.T) {
+	// Given
+	input := "\r\n\t|line 1|\r\n\t|line 2|\r\n\t"
+
+	// When
+	result, err := text.StripColumnStrict(input)
+
+	// Then
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := "line 1\r\nline 2"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
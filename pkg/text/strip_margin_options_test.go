@@ -0,0 +1,122 @@
+package text_test
+
+import (
+	"testing"
+
+	"github.com/shapestone/textsmith/pkg/text"
+)
+
+func TestStripMarginWithOptions_WithGreaterThanMarker_KeepsPipesInContent(t *testing.T) {
+	// Given
+	input := `
+	>| name  | age |
+	>| ----- | --- |
+	>| Alice | 30  |
+	`
+
+	// When
+	result := text.StripMarginWithOptions(input, text.MarginOptions{Marker: ">"})
+
+	// Then
+	expected := "| name  | age |\n| ----- | --- |\n| Alice | 30  |"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestStripMarginWithOptions_WithHashMarker_StripsShellPipeline(t *testing.T) {
+	// Given
+	input := `
+		#cat access.log | grep 404 | sort | uniq -c
+		#echo done || exit 1`
+
+	// When
+	result := text.StripMarginWithOptions(input, text.MarginOptions{Marker: "#"})
+
+	// Then
+	expected := "cat access.log | grep 404 | sort | uniq -c\necho done || exit 1"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestStripMarginWithOptions_WithMultiByteMarker_StripsMarker(t *testing.T) {
+	// Given
+	input := "\n\t┃SELECT a || b\n\t┃FROM t\n\t"
+
+	// When
+	result := text.StripMarginWithOptions(input, text.MarginOptions{Marker: "┃"})
+
+	// Then
+	expected := "SELECT a || b\nFROM t"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestStripMarginWithOptions_WithRegexMetacharacterMarker_MatchesLiterally(t *testing.T) {
+	// Given
+	input := "\n  .*line 1\n  xxline 2\n  .*line 3"
+
+	// When
+	result := text.StripMarginWithOptions(input, text.MarginOptions{Marker: ".*"})
+
+	// Then
+	expected := "line 1\nline 3"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestStripMarginWithOptions_WithEmptyMarker_BehavesLikeStripMargin(t *testing.T) {
+	// Given
+	input := `
+	|line 1
+	|  line 2
+	no margin
+	|line 3`
+
+	// When
+	result := text.StripMarginWithOptions(input, text.MarginOptions{})
+
+	// Then
+	expected := text.StripMargin(input)
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestStripColumnWithOptions_WithCustomMarker_StripsEnclosingMarkers(t *testing.T) {
+	// Given
+	input := `
+	┃a | b  ┃
+	┃c || d ┃  
+	┃missing close
+	`
+
+	// When
+	result := text.StripColumnWithOptions(input, text.MarginOptions{Marker: "┃"})
+
+	// Then
+	expected := "a | b  \nc || d "
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestStripColumnWithOptions_WithDefaultMarker_BehavesLikeStripColumn(t *testing.T) {
+	// Given
+	input := `
+	|line 1 |
+	|line 2|
+	`
+
+	// When
+	result := text.StripColumnWithOptions(input, text.MarginOptions{Marker: text.DefaultMarginMarker})
+
+	// Then
+	expected := text.StripColumn(input)
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
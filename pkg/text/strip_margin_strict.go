@@ -0,0 +1,113 @@
+package text
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MarginReason tells why a source line would be dropped by StripMargin or StripColumn
+type MarginReason int
+
+const (
+	// ReasonMissingMargin marks a line without the leading margin marker
+	ReasonMissingMargin MarginReason = iota + 1
+	// ReasonMissingClosingPipe marks a StripColumn line without a closing marker
+	ReasonMissingClosingPipe
+	// ReasonTrailingContent marks a StripColumn line with text after the closing marker
+	ReasonTrailingContent
+)
+
+func (r MarginReason) String() string {
+	switch r {
+	case ReasonMissingMargin:
+		return "missing margin"
+	case ReasonMissingClosingPipe:
+		return "missing closing pipe"
+	case ReasonTrailingContent:
+		return "trailing content after closing pipe"
+	}
+	return fmt.Sprintf("MarginReason(%d)", int(r))
+}
+
+// MarginProblem describes a single malformed source line
+type MarginProblem struct {
+	// Line is the 1-based line number in the input
+	Line int
+	// Reason tells why the line is malformed
+	Reason MarginReason
+	// Text is the offending line as written, without its line ending
+	Text string
+}
+
+// MarginError is returned by the strict variants and lists every malformed source line
+type MarginError struct {
+	Problems []MarginProblem
+}
+
+func (e *MarginError) Error() string {
+	var sb strings.Builder
+	sb.WriteString("malformed margin text: ")
+	for i, p := range e.Problems {
+		if i > 0 {
+			sb.WriteString("; ")
+		}
+		sb.WriteString(fmt.Sprintf("line %d: %s in %q", p.Line, p.Reason, p.Text))
+	}
+	return sb.String()
+}
+
+// StripMarginStrict works like StripMargin but returns a *MarginError instead of silently dropping lines without
+// a margin. A blank first and last line, as left by the backticks of a raw string, are allowed.
+func StripMarginStrict(s string) (string, error) {
+	if problems := checkMargin(s, DefaultMarginMarker, false); len(problems) > 0 {
+		return "", &MarginError{Problems: problems}
+	}
+	return StripMargin(s), nil
+}
+
+// StripColumnStrict works like StripColumn but returns a *MarginError instead of silently dropping lines without
+// a margin, without a closing pipe, or with trailing content after the closing pipe. A blank first and last line,
+// as left by the backticks of a raw string, are allowed.
+func StripColumnStrict(s string) (string, error) {
+	if problems := checkMargin(s, DefaultMarginMarker, true); len(problems) > 0 {
+		return "", &MarginError{Problems: problems}
+	}
+	return StripColumn(s), nil
+}
+
+// checkMargin returns the problems of every line that StripMargin, or StripColumn when column is set, would drop
+func checkMargin(s string, marker string, column bool) []MarginProblem {
+	if s == "" {
+		return nil
+	}
+
+	var problems []MarginProblem
+	lines := splitSourceLines(s)
+	for i, line := range lines {
+		if (i == 0 || i == len(lines)-1) && strings.Trim(line.text, " \t") == "" {
+			continue
+		}
+		if reason := checkMarginLine(line.text, marker, column); reason != 0 {
+			problems = append(problems, MarginProblem{Line: i + 1, Reason: reason, Text: line.text})
+		}
+	}
+	return problems
+}
+
+// checkMarginLine returns why a single line would be dropped, or zero when it is well-formed
+func checkMarginLine(line string, marker string, column bool) MarginReason {
+	rest, ok := marginContent(line, marker)
+	if !ok {
+		return ReasonMissingMargin
+	}
+	if !column {
+		return 0
+	}
+	if _, ok := columnContent(line, marker); ok {
+		return 0
+	}
+	if strings.Contains(rest, marker) {
+		return ReasonTrailingContent
+	}
+	return ReasonMissingClosingPipe
+}
@@ -0,0 +1,140 @@
+package text_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/shapestone/textsmith/pkg/text"
+)
+
+func TestStripMarginStrict_WithWellFormedInput_ReturnsStrippedContent(t *testing.T) {
+	// Given
+	input := `
+	|line 1
+	|
+	|line 3
+	`
+
+	// When
+	result, err := text.StripMarginStrict(input)
+
+	// Then
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := "line 1\n\nline 3"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestStripMarginStrict_WithMissingMargins_ReportsEveryLine(t *testing.T) {
+	// Given
+	input := "\n\t|line 1\n\tline 2\n\n\t|line 4\n\t"
+
+	// When
+	result, err := text.StripMarginStrict(input)
+
+	// Then
+	var marginErr *text.MarginError
+	if !errors.As(err, &marginErr) {
+		t.Fatalf("Expected *text.MarginError, got %v", err)
+	}
+	if result != "" {
+		t.Errorf("Expected empty result on error, got %q", result)
+	}
+
+	expected := []text.MarginProblem{
+		{Line: 3, Reason: text.ReasonMissingMargin, Text: "\tline 2"},
+		{Line: 4, Reason: text.ReasonMissingMargin, Text: ""},
+	}
+	if !reflect.DeepEqual(marginErr.Problems, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, marginErr.Problems)
+	}
+}
+
+func TestStripMarginStrict_WithEmptyString_ReturnsEmptyString(t *testing.T) {
+	// When
+	result, err := text.StripMarginStrict("")
+
+	// Then
+	if err != nil || result != "" {
+		t.Fatalf("Expected empty result without error, got %q, %v", result, err)
+	}
+}
+
+func TestStripColumnStrict_WithWellFormedInput_ReturnsStrippedContent(t *testing.T) {
+	// Given
+	input := `
+	|line 1  |
+	|a | b|
+	`
+
+	// When
+	result, err := text.StripColumnStrict(input)
+
+	// Then
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := "line 1  \na | b"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestStripColumnStrict_WithMalformedLines_ReportsLineNumbersAndReasons(t *testing.T) {
+	// Given
+	input := `
+	|valid line|
+	|missing close
+	no margin|
+	|content| trailing
+	|another valid|
+	`
+
+	// When
+	_, err := text.StripColumnStrict(input)
+
+	// Then
+	var marginErr *text.MarginError
+	if !errors.As(err, &marginErr) {
+		t.Fatalf("Expected *text.MarginError, got %v", err)
+	}
+
+	expected := []text.MarginProblem{
+		{Line: 3, Reason: text.ReasonMissingClosingPipe, Text: "\t|missing close"},
+		{Line: 4, Reason: text.ReasonMissingMargin, Text: "\tno margin|"},
+		{Line: 5, Reason: text.ReasonTrailingContent, Text: "\t|content| trailing"},
+	}
+	if !reflect.DeepEqual(marginErr.Problems, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, marginErr.Problems)
+	}
+}
+
+func TestMarginError_WithProblems_ListsEachLineInMessage(t *testing.T) {
+	// Given
+	_, err := text.StripColumnStrict("|ok|\n|open\n|x| y")
+
+	// When
+	message := err.Error()
+
+	// Then
+	expected := `malformed margin text: line 2: missing closing pipe in "|open"; ` +
+		`line 3: trailing content after closing pipe in "|x| y"`
+	if message != expected {
+		t.Fatalf("Expected %q, got %q", expected, message)
+	}
+}
+
+func TestStripColumnStrict_WithSinglePipe_ReportsMissingClosingPipe(t *testing.T) {
+	// When
+	_, err := text.StripColumnStrict("|")
+
+	// Then
+	var marginErr *text.MarginError
+	if !errors.As(err, &marginErr) || marginErr.Problems[0].Reason != text.ReasonMissingClosingPipe {
+		t.Fatalf("Expected a missing closing pipe problem, got %v", err)
+	}
+}
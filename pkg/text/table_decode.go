@@ -0,0 +1,261 @@
+package text
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TableError reports a problem with a single row of a decoded table
+type TableError struct {
+	// Row is the 1-based row of the table, the header being row 1
+	Row int
+	// Column is the header name of the offending cell, empty for row-level problems
+	Column string
+	Err    error
+}
+
+func (e *TableError) Error() string {
+	if e.Column == "" {
+		return fmt.Sprintf("table row %d: %v", e.Row, e.Err)
+	}
+	return fmt.Sprintf("table row %d, column %q: %v", e.Row, e.Column, e.Err)
+}
+
+func (e *TableError) Unwrap() error {
+	return e.Err
+}
+
+// tableRow is a row of cells together with its 1-based row number
+type tableRow struct {
+	number int
+	cells  []string
+}
+
+// ParseTable splits the output of StripColumn into rows of cells. Cells are separated by | and trimmed of their
+// padding, a literal pipe inside a cell is written as \|. Blank lines and Markdown separator rows such as
+// "--- | :---:" are skipped.
+//
+// Code example:
+//
+//	rows := text.ParseTable(text.StripColumn(`
+//	    |name  | age|
+//	    |Alice | 30 |
+//	`))
+func ParseTable(s string) [][]string {
+	var rows [][]string
+	for _, row := range parseTableRows(s) {
+		rows = append(rows, row.cells)
+	}
+	return rows
+}
+
+// parseTableRows splits the table into numbered rows
+func parseTableRows(s string) []tableRow {
+	var rows []tableRow
+	number := 0
+	for _, line := range strings.Split(s, "\n") {
+		line = strings.TrimSuffix(line, "\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		cells := splitCells(line)
+		if isSeparatorRow(cells) {
+			continue
+		}
+		number++
+		rows = append(rows, tableRow{number: number, cells: cells})
+	}
+	return rows
+}
+
+// splitCells splits a row on unescaped pipes and trims the cells
+func splitCells(line string) []string {
+	var cells []string
+	var cell strings.Builder
+	for i := 0; i < len(line); i++ {
+		switch {
+		case line[i] == '\\' && i+1 < len(line) && line[i+1] == '|':
+			cell.WriteByte('|')
+			i++
+		case line[i] == '|':
+			cells = append(cells, strings.TrimSpace(cell.String()))
+			cell.Reset()
+		default:
+			cell.WriteByte(line[i])
+		}
+	}
+	return append(cells, strings.TrimSpace(cell.String()))
+}
+
+// isSeparatorRow reports whether every cell is a Markdown alignment marker such as ---, :-- or :-:
+func isSeparatorRow(cells []string) bool {
+	for _, cell := range cells {
+		trimmed := strings.Trim(cell, ":")
+		if trimmed == "" || strings.Trim(trimmed, "-") != "" {
+			return false
+		}
+	}
+	return true
+}
+
+var (
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	errTableDst         = errors.New("destination must be a pointer to a slice of structs")
+)
+
+// DecodeTable decodes a table into a slice of structs for table-driven tests, similar to Gherkin data tables.
+// The input is the output of StripColumn, split as described on ParseTable. The first row is the header, every
+// further row becomes one element appended to the slice dst points to.
+//
+// A header cell selects the struct field with a matching `table:"name"` tag, or otherwise the field whose name
+// equals the header ignoring case and spaces. Fields tagged `table:"-"` are never set. Cells are converted to
+// strings, decimal integers (leading zeros allowed), floats, booleans, time.Duration, pointers to those, and types
+// implementing encoding.TextUnmarshaler. Empty cells leave the field at its zero value.
+//
+// Code example:
+//
+//	var cases []struct {
+//	    Name    string        `table:"name"`
+//	    Age     int           `table:"age"`
+//	    Timeout time.Duration `table:"timeout"`
+//	}
+//	err := text.DecodeTable(text.StripColumn(`
+//	    |name  | age | timeout|
+//	    |Alice | 30  | 1s     |
+//	    |Bob   | 41  | 250ms  |
+//	`), &cases)
+//
+// Problems are reported as *TableError carrying the row number.
+func DecodeTable(s string, dst any) error {
+	slice := reflect.ValueOf(dst)
+	if slice.Kind() != reflect.Pointer || slice.IsNil() || slice.Elem().Kind() != reflect.Slice ||
+		slice.Elem().Type().Elem().Kind() != reflect.Struct {
+		return errTableDst
+	}
+	slice = slice.Elem()
+	elemType := slice.Type().Elem()
+
+	rows := parseTableRows(s)
+	if len(rows) == 0 {
+		return nil
+	}
+
+	header := rows[0]
+	fields := make([]int, len(header.cells))
+	for i, name := range header.cells {
+		field, ok := tableField(elemType, name)
+		if !ok {
+			return &TableError{Row: header.number, Column: name, Err: errors.New("no matching struct field")}
+		}
+		fields[i] = field
+	}
+
+	for _, row := range rows[1:] {
+		if len(row.cells) != len(header.cells) {
+			return &TableError{
+				Row: row.number,
+				Err: fmt.Errorf("expected %d cells, got %d", len(header.cells), len(row.cells)),
+			}
+		}
+
+		elem := reflect.New(elemType).Elem()
+		for i, cell := range row.cells {
+			if err := setCell(elem.Field(fields[i]), cell); err != nil {
+				return &TableError{Row: row.number, Column: header.cells[i], Err: err}
+			}
+		}
+		slice.Set(reflect.Append(slice, elem))
+	}
+
+	return nil
+}
+
+// tableField finds the index of the exported struct field a header cell refers to
+func tableField(t reflect.Type, name string) (int, bool) {
+	normalized := strings.ToLower(strings.ReplaceAll(name, " ", ""))
+	fallback := -1
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		tag, hasTag := f.Tag.Lookup("table")
+		if tag == "-" {
+			continue
+		}
+		if hasTag && tag == name {
+			return i, true
+		}
+		if !hasTag && fallback < 0 && strings.ToLower(f.Name) == normalized {
+			fallback = i
+		}
+	}
+	return fallback, fallback >= 0
+}
+
+// setCell converts a cell to the type of a struct field and assigns it
+func setCell(field reflect.Value, cell string) error {
+	if cell == "" {
+		return nil
+	}
+
+	if field.Kind() == reflect.Pointer {
+		ptr := reflect.New(field.Type().Elem())
+		if err := setCell(ptr.Elem(), cell); err != nil {
+			return err
+		}
+		field.Set(ptr)
+		return nil
+	}
+
+	if field.Addr().Type().Implements(textUnmarshalerType) {
+		return field.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(cell))
+	}
+
+	if field.Type() == durationType {
+		d, err := time.ParseDuration(cell)
+		if err != nil {
+			return fmt.Errorf("invalid duration %q", cell)
+		}
+		field.SetInt(int64(d))
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(cell)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(cell)
+		if err != nil {
+			return fmt.Errorf("invalid bool %q", cell)
+		}
+		field.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(cell, 10, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid %s %q", field.Type(), cell)
+		}
+		field.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(cell, 10, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid %s %q", field.Type(), cell)
+		}
+		field.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(cell, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid %s %q", field.Type(), cell)
+		}
+		field.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported field type %s", field.Type())
+	}
+
+	return nil
+}
@@ -0,0 +1,225 @@
+package text_test
+
+import (
+	"errors"
+	"net/netip"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/shapestone/textsmith/pkg/text"
+)
+
+func TestParseTable_WithPaddedCells_ReturnsTrimmedCells(t *testing.T) {
+	// Given
+	input := text.StripColumn(`
+		|name  | age|
+		|Alice | 30 |
+		|Bob   |    |
+	`)
+
+	// When
+	rows := text.ParseTable(input)
+
+	// Then
+	expected := [][]string{{"name", "age"}, {"Alice", "30"}, {"Bob", ""}}
+	if !reflect.DeepEqual(rows, expected) {
+		t.Fatalf("Expected %q, got %q", expected, rows)
+	}
+}
+
+func TestParseTable_WithEscapedPipeAndSeparatorRow_KeepsLiteralPipe(t *testing.T) {
+	// Given
+	input := text.StripColumn(`
+		|expr    | result|
+		|------- | :----:|
+		|a \|\| b | true  |
+	`)
+
+	// When
+	rows := text.ParseTable(input)
+
+	// Then
+	expected := [][]string{{"expr", "result"}, {"a || b", "true"}}
+	if !reflect.DeepEqual(rows, expected) {
+		t.Fatalf("Expected %q, got %q", expected, rows)
+	}
+}
+
+type tableCase struct {
+	Name     string        `table:"name"`
+	Age      int           `table:"age"`
+	Active   bool          `table:"active"`
+	Timeout  time.Duration `table:"timeout"`
+	Score    float64
+	Nickname *string
+	Addr     netip.Addr `table:"addr"`
+	Ignored  string     `table:"-"`
+}
+
+func TestDecodeTable_WithTaggedStruct_DecodesAllRows(t *testing.T) {
+	// Given
+	input := text.StripColumn(`
+		|name  | age | active | timeout | score | nickname | addr     |
+		|Alice | 30  | true   | 1s      | 9.5   | Al       | 10.0.0.1 |
+		|Bob   | 41  | false  | 250ms   |       |          | ::1      |
+	`)
+	var cases []tableCase
+
+	// When
+	err := text.DecodeTable(input, &cases)
+
+	// Then
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	nickname := "Al"
+	expected := []tableCase{
+		{Name: "Alice", Age: 30, Active: true, Timeout: time.Second, Score: 9.5, Nickname: &nickname,
+			Addr: netip.MustParseAddr("10.0.0.1")},
+		{Name: "Bob", Age: 41, Timeout: 250 * time.Millisecond, Addr: netip.MustParseAddr("::1")},
+	}
+	if diff, match := text.DiffValues(expected, cases); !match {
+		t.Fatalf("Decoded rows do not match expected:\n\n%s", diff)
+	}
+}
+
+func TestDecodeTable_WithUntaggedHeaderWithSpaces_MatchesFieldName(t *testing.T) {
+	// Given
+	input := text.StripColumn(`
+		|first name | LAST NAME|
+		|Ada        | Lovelace |
+	`)
+	var people []struct {
+		FirstName string
+		LastName  string
+	}
+
+	// When
+	err := text.DecodeTable(input, &people)
+
+	// Then
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(people) != 1 || people[0].FirstName != "Ada" || people[0].LastName != "Lovelace" {
+		t.Fatalf("Unexpected result %+v", people)
+	}
+}
+
+func TestDecodeTable_WithZeroPaddedNumbers_DecodesAsDecimal(t *testing.T) {
+	// Given
+	input := text.StripColumn(`
+		|id  | zip   |
+		|010 | 08001 |
+		|008 | 00501 |
+	`)
+	var rows []struct {
+		ID  int    `table:"id"`
+		Zip uint32 `table:"zip"`
+	}
+
+	// When
+	err := text.DecodeTable(input, &rows)
+
+	// Then
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(rows) != 2 || rows[0].ID != 10 || rows[0].Zip != 8001 || rows[1].ID != 8 || rows[1].Zip != 501 {
+		t.Fatalf("Unexpected result %+v", rows)
+	}
+}
+
+func TestDecodeTable_WithInvalidInt_ReportsRowAndColumn(t *testing.T) {
+	// Given
+	input := text.StripColumn(`
+		|name  | age|
+		|Alice | 30 |
+		|Bob   | x  |
+	`)
+	var cases []tableCase
+
+	// When
+	err := text.DecodeTable(input, &cases)
+
+	// Then
+	var tableErr *text.TableError
+	if !errors.As(err, &tableErr) {
+		t.Fatalf("Expected *text.TableError, got %v", err)
+	}
+	if tableErr.Row != 3 || tableErr.Column != "age" {
+		t.Fatalf("Expected row 3, column age, got row %d, column %q", tableErr.Row, tableErr.Column)
+	}
+	expected := `table row 3, column "age": invalid int "x"`
+	if err.Error() != expected {
+		t.Fatalf("Expected %q, got %q", expected, err.Error())
+	}
+}
+
+func TestDecodeTable_WithWrongCellCount_ReportsRow(t *testing.T) {
+	// Given
+	input := text.StripColumn(`
+		|name  | age|
+		|Alice |
+	`)
+	var cases []tableCase
+
+	// When
+	err := text.DecodeTable(input, &cases)
+
+	// Then
+	expected := "table row 2: expected 2 cells, got 1"
+	if err == nil || err.Error() != expected {
+		t.Fatalf("Expected %q, got %v", expected, err)
+	}
+}
+
+func TestDecodeTable_WithUnknownHeader_ReportsHeaderRow(t *testing.T) {
+	// Given
+	input := text.StripColumn(`
+		|name  | height|
+		|Alice | 170   |
+	`)
+	var cases []tableCase
+
+	// When
+	err := text.DecodeTable(input, &cases)
+
+	// Then
+	expected := `table row 1, column "height": no matching struct field`
+	if err == nil || err.Error() != expected {
+		t.Fatalf("Expected %q, got %v", expected, err)
+	}
+}
+
+func TestDecodeTable_WithIgnoredField_DoesNotMatchHeader(t *testing.T) {
+	// Given
+	input := text.StripColumn(`
+		|ignored|
+		|value  |
+	`)
+	var cases []tableCase
+
+	// When
+	err := text.DecodeTable(input, &cases)
+
+	// Then
+	if err == nil {
+		t.Fatalf("Expected an error for a header that only matches an ignored field")
+	}
+}
+
+func TestDecodeTable_WithInvalidDestination_ReturnsError(t *testing.T) {
+	// Given
+	input := "name\nAlice"
+	var notASlice tableCase
+
+	// When
+	err := text.DecodeTable(input, &notASlice)
+
+	// Then
+	if err == nil {
+		t.Fatalf("Expected an error for a non-slice destination")
+	}
+}
@@ -0,0 +1,244 @@
+package text
+
+import (
+	"strings"
+)
+
+// TableBorder selects the border style of FormatTableWithOptions
+type TableBorder int
+
+const (
+	// BorderASCII draws borders with +, - and |
+	BorderASCII TableBorder = iota
+	// BorderUnicode draws borders with box drawing characters
+	BorderUnicode
+	// BorderMarkdown renders a GitHub Flavored Markdown table
+	BorderMarkdown
+	// BorderNone separates columns with two spaces and draws no borders
+	BorderNone
+)
+
+// TableOverflow selects what happens to cell lines wider than the maximum width of their column
+type TableOverflow int
+
+const (
+	// OverflowWrap wraps cells with Wrap and truncates lines that still do not fit, such as long words
+	OverflowWrap TableOverflow = iota
+	// OverflowTruncate cuts cell lines and ends them with an ellipsis
+	OverflowTruncate
+)
+
+// TableOptions configures FormatTableWithOptions
+type TableOptions struct {
+	// Border is the border style, BorderASCII by default
+	Border TableBorder
+	// Align holds the alignment of each column; columns without an entry and AlignJustify are aligned left
+	Align []Alignment
+	// MaxWidths holds the maximum display width of each column; zero, negative or missing means unlimited
+	MaxWidths []int
+	// Overflow selects wrapping or truncation of cells wider than their maximum width
+	Overflow TableOverflow
+}
+
+// borderChars holds the characters of a border style
+type borderChars struct {
+	horizontal, vertical                  string
+	topLeft, topMiddle, topRight          string
+	middleLeft, middleMiddle, middleRight string
+	bottomLeft, bottomMiddle, bottomRight string
+}
+
+var (
+	asciiBorder   = borderChars{"-", "|", "+", "+", "+", "+", "+", "+", "+", "+", "+"}
+	unicodeBorder = borderChars{"─", "│", "┌", "┬", "┐", "├", "┼", "┤", "└", "┴", "┘"}
+)
+
+// FormatTable renders a header and rows as a table with ASCII borders, measuring cells in display width so wide
+// characters line up. The header is optional, rows may have different lengths, and cells may span several lines.
+//
+// Code example:
+//
+//	text.FormatTable([]string{"name", "age"}, [][]string{{"Alice", "30"}})
+//	// +-------+-----+
+//	// | name  | age |
+//	// +-------+-----+
+//	// | Alice | 30  |
+//	// +-------+-----+
+func FormatTable(header []string, rows [][]string) string {
+	return FormatTableWithOptions(header, rows, TableOptions{})
+}
+
+// FormatTableWithOptions works like FormatTable with a border style, per-column alignment and maximum column
+// widths. Markdown tables escape pipes in cells as \| and join the lines of multi-line cells with <br>, and get an
+// empty header row when header is nil. Tabs and other control characters have no display width, so expand them
+// before formatting.
+func FormatTableWithOptions(header []string, rows [][]string, opts TableOptions) string {
+	all := rows
+	if header != nil {
+		all = append([][]string{header}, rows...)
+	}
+	columns := 0
+	for _, row := range all {
+		columns = max(columns, len(row))
+	}
+	if columns == 0 {
+		return ""
+	}
+
+	// Split every cell into its display lines and measure the columns
+	cells := make([][][]string, len(all))
+	widths := make([]int, columns)
+	for r, row := range all {
+		cells[r] = make([][]string, columns)
+		for c := 0; c < columns; c++ {
+			cell := ""
+			if c < len(row) {
+				cell = row[c]
+			}
+			lines := cellLines(cell, columnOption(opts.MaxWidths, c), opts)
+			if opts.Border == BorderMarkdown {
+				lines = []string{strings.ReplaceAll(strings.Join(lines, "<br>"), "|", `\|`)}
+			}
+			cells[r][c] = lines
+			for _, line := range lines {
+				widths[c] = max(widths[c], DisplayWidth(line))
+			}
+		}
+	}
+
+	aligns := make([]Alignment, columns)
+	for c := range aligns {
+		aligns[c] = Alignment(columnOption(opts.Align, c))
+	}
+
+	var lines []string
+	switch opts.Border {
+	case BorderMarkdown:
+		for c := range widths {
+			widths[c] = max(widths[c], 3)
+		}
+		if header == nil {
+			// A GitHub Flavored Markdown table needs a header row, so an empty one is written
+			empty := make([][]string, columns)
+			for c := range empty {
+				empty[c] = []string{""}
+			}
+			lines = append(lines, formatTableRow(empty, widths, aligns, "| ", " | ", " |")...)
+			lines = append(lines, markdownSeparator(widths, aligns))
+		}
+		for r := range cells {
+			lines = append(lines, formatTableRow(cells[r], widths, aligns, "| ", " | ", " |")...)
+			if r == 0 && header != nil {
+				lines = append(lines, markdownSeparator(widths, aligns))
+			}
+		}
+	case BorderNone:
+		for r := range cells {
+			for _, line := range formatTableRow(cells[r], widths, aligns, "", "  ", "") {
+				lines = append(lines, strings.TrimRight(line, " "))
+			}
+		}
+	default:
+		b := asciiBorder
+		if opts.Border == BorderUnicode {
+			b = unicodeBorder
+		}
+		lines = append(lines, borderLine(widths, b.horizontal, b.topLeft, b.topMiddle, b.topRight))
+		for r := range cells {
+			lines = append(lines, formatTableRow(cells[r], widths, aligns, b.vertical+" ", " "+b.vertical+" ", " "+b.vertical)...)
+			if r == 0 && header != nil && len(cells) > 1 {
+				lines = append(lines, borderLine(widths, b.horizontal, b.middleLeft, b.middleMiddle, b.middleRight))
+			}
+		}
+		lines = append(lines, borderLine(widths, b.horizontal, b.bottomLeft, b.bottomMiddle, b.bottomRight))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// columnOption returns the option of column c, or zero when it has none
+func columnOption[T ~int](values []T, c int) T {
+	if c < len(values) {
+		return values[c]
+	}
+	return 0
+}
+
+// cellLines splits a cell into lines that fit maxWidth, when it is positive
+func cellLines(cell string, maxWidth int, opts TableOptions) []string {
+	cell = strings.ReplaceAll(cell, "\r\n", "\n")
+	if maxWidth > 0 && opts.Overflow == OverflowWrap {
+		cell = Wrap(cell, maxWidth)
+	}
+	lines := strings.Split(cell, "\n")
+	if maxWidth > 0 {
+		for i, line := range lines {
+			lines[i] = Truncate(line, maxWidth)
+		}
+	}
+	return lines
+}
+
+// formatTableRow renders the lines of a row of cells between the given separators
+func formatTableRow(row [][]string, widths []int, aligns []Alignment, left, middle, right string) []string {
+	height := 0
+	for _, cell := range row {
+		height = max(height, len(cell))
+	}
+
+	lines := make([]string, height)
+	for i := range lines {
+		var sb strings.Builder
+		sb.WriteString(left)
+		for c, cell := range row {
+			if c > 0 {
+				sb.WriteString(middle)
+			}
+			line := ""
+			if i < len(cell) {
+				line = cell[i]
+			}
+			switch aligns[c] {
+			case AlignRight:
+				sb.WriteString(PadLeft(line, widths[c]))
+			case AlignCenter:
+				sb.WriteString(PadCenter(line, widths[c]))
+			default:
+				sb.WriteString(PadRight(line, widths[c]))
+			}
+		}
+		sb.WriteString(right)
+		lines[i] = sb.String()
+	}
+	return lines
+}
+
+// borderLine renders a horizontal border across all columns
+func borderLine(widths []int, horizontal, left, middle, right string) string {
+	var sb strings.Builder
+	sb.WriteString(left)
+	for c, width := range widths {
+		if c > 0 {
+			sb.WriteString(middle)
+		}
+		sb.WriteString(strings.Repeat(horizontal, width+2))
+	}
+	sb.WriteString(right)
+	return sb.String()
+}
+
+// markdownSeparator renders the delimiter row of a Markdown table with alignment colons
+func markdownSeparator(widths []int, aligns []Alignment) string {
+	var sb strings.Builder
+	sb.WriteString("|")
+	for c, width := range widths {
+		switch aligns[c] {
+		case AlignRight:
+			sb.WriteString(" " + strings.Repeat("-", width-1) + ": |")
+		case AlignCenter:
+			sb.WriteString(" :" + strings.Repeat("-", width-2) + ": |")
+		default:
+			sb.WriteString(" " + strings.Repeat("-", width) + " |")
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,182 @@
+package text_test
+
+import (
+	"testing"
+
+	"github.com/shapestone/textsmith/pkg/text"
+)
+
+func TestFormatTable_WithHeaderAndRows_DrawsASCIIBorders(t *testing.T) {
+	// Given
+	header := []string{"name", "age"}
+	rows := [][]string{{"Alice", "30"}, {"Bob"}}
+
+	// When
+	result := text.FormatTable(header, rows)
+
+	// Then
+	expected := text.StripMargin(`
+		|+-------+-----+
+		|| name  | age |
+		|+-------+-----+
+		|| Alice | 30  |
+		|| Bob   |     |
+		|+-------+-----+`)
+	if diff, ok := text.Diff(expected, result); !ok {
+		t.Fatalf("Unexpected table:\n%s", diff)
+	}
+}
+
+func TestFormatTableWithOptions_WithUnicodeBorderAndAlignment_AlignsColumns(t *testing.T) {
+	// Given
+	header := []string{"item", "qty", "status"}
+	rows := [][]string{{"apples", "3", "ok"}, {"kiwis", "120", "low"}}
+	opts := text.TableOptions{
+		Border: text.BorderUnicode,
+		Align:  []text.Alignment{text.AlignLeft, text.AlignRight, text.AlignCenter},
+	}
+
+	// When
+	result := text.FormatTableWithOptions(header, rows, opts)
+
+	// Then
+	expected := text.StripMargin(`
+		|┌────────┬─────┬────────┐
+		|│ item   │ qty │ status │
+		|├────────┼─────┼────────┤
+		|│ apples │   3 │   ok   │
+		|│ kiwis  │ 120 │  low   │
+		|└────────┴─────┴────────┘`)
+	if diff, ok := text.Diff(expected, result); !ok {
+		t.Fatalf("Unexpected table:\n%s", diff)
+	}
+}
+
+func TestFormatTableWithOptions_WithWideCharacters_LinesUpByDisplayWidth(t *testing.T) {
+	// Given
+	header := []string{"city", "pop"}
+	rows := [][]string{{"東京", "14M"}, {"Paris", "2M"}}
+
+	// When
+	result := text.FormatTableWithOptions(header, rows, text.TableOptions{Border: text.BorderUnicode})
+
+	// Then
+	expected := text.StripMargin(`
+		|┌───────┬─────┐
+		|│ city  │ pop │
+		|├───────┼─────┤
+		|│ 東京  │ 14M │
+		|│ Paris │ 2M  │
+		|└───────┴─────┘`)
+	if diff, ok := text.Diff(expected, result); !ok {
+		t.Fatalf("Unexpected table:\n%s", diff)
+	}
+}
+
+func TestFormatTableWithOptions_WithMarkdownBorderWithoutHeader_WritesEmptyHeaderRow(t *testing.T) {
+	// Given
+	rows := [][]string{{"a", "b"}, {"cc", "d"}}
+
+	// When
+	result := text.FormatTableWithOptions(nil, rows, text.TableOptions{Border: text.BorderMarkdown})
+
+	// Then
+	expected := text.StripMargin(`
+		||     |     |
+		|| --- | --- |
+		|| a   | b   |
+		|| cc  | d   |`)
+	if diff, ok := text.Diff(expected, result); !ok {
+		t.Fatalf("Unexpected table:\n%s", diff)
+	}
+}
+
+func TestFormatTableWithOptions_WithMarkdownBorder_EscapesPipesAndJoinsLines(t *testing.T) {
+	// Given
+	header := []string{"expr", "n"}
+	rows := [][]string{{"a | b", "1"}, {"two\nlines", "22"}}
+	opts := text.TableOptions{
+		Border: text.BorderMarkdown,
+		Align:  []text.Alignment{text.AlignCenter, text.AlignRight},
+	}
+
+	// When
+	result := text.FormatTableWithOptions(header, rows, opts)
+
+	// Then
+	expected := text.StripMargin(`
+		||     expr     |   n |
+		|| :----------: | --: |
+		||    a \| b    |   1 |
+		|| two<br>lines |  22 |`)
+	if diff, ok := text.Diff(expected, result); !ok {
+		t.Fatalf("Unexpected table:\n%s", diff)
+	}
+	if cells := text.ParseTable(text.StripColumn(result)); cells[1][0] != "a | b" {
+		t.Fatalf("Expected ParseTable to read the escaped pipe back, got %q", cells[1][0])
+	}
+}
+
+func TestFormatTableWithOptions_WithNoBorder_TrimsTrailingSpaces(t *testing.T) {
+	// Given
+	rows := [][]string{{"a", "long value", "x"}, {"bbb", "v", ""}}
+
+	// When
+	result := text.FormatTableWithOptions(nil, rows, text.TableOptions{Border: text.BorderNone})
+
+	// Then
+	expected := "a    long value  x\nbbb  v"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestFormatTableWithOptions_WithMaxWidthAndWrap_RendersMultiLineCells(t *testing.T) {
+	// Given
+	header := []string{"key", "description"}
+	rows := [][]string{{"wrap", "a long description that wraps"}, {"multi", "first\nsecond"}}
+	opts := text.TableOptions{MaxWidths: []int{0, 12}}
+
+	// When
+	result := text.FormatTableWithOptions(header, rows, opts)
+
+	// Then
+	expected := text.StripMargin(`
+		|+-------+-------------+
+		|| key   | description |
+		|+-------+-------------+
+		|| wrap  | a long      |
+		||       | description |
+		||       | that wraps  |
+		|| multi | first       |
+		||       | second      |
+		|+-------+-------------+`)
+	if diff, ok := text.Diff(expected, result); !ok {
+		t.Fatalf("Unexpected table:\n%s", diff)
+	}
+}
+
+func TestFormatTableWithOptions_WithMaxWidthAndTruncate_CutsWithEllipsis(t *testing.T) {
+	// Given
+	rows := [][]string{{"/usr/local/share/textsmith", "ok"}, {"日本語のパス", "ok"}}
+	opts := text.TableOptions{MaxWidths: []int{9}, Overflow: text.OverflowTruncate, Border: text.BorderNone}
+
+	// When
+	result := text.FormatTableWithOptions(nil, rows, opts)
+
+	// Then
+	expected := "/usr/loc…  ok\n日本語の…  ok"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestFormatTable_WithNoCells_ReturnsEmptyString(t *testing.T) {
+	// When
+	result := text.FormatTable(nil, nil)
+
+	// Then
+	if result != "" {
+		t.Fatalf("Expected empty string, got %q", result)
+	}
+}
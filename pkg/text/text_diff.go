@@ -2,7 +2,6 @@ package text
 
 import (
 	"strings"
-	"unicode/utf8"
 )
 
 // DiffResult represents the result of comparing two strings
@@ -31,13 +30,9 @@ const (
 	DiffStatusMissingInExpected
 )
 
-// rpad is a right space padding function
+// rpad is a right space padding function that measures display width, so wide characters line up
 func rpad(str string, length int) string {
-	rc := length - utf8.RuneCountInString(str)
-	if rc <= 0 {
-		return str
-	}
-	return str + strings.Repeat(` `, rc)
+	return PadRight(str, length)
 }
 
 // stringDiff compares two strings and returns a visual indicator of where they differ
@@ -52,7 +47,7 @@ func stringDiff(a, b string) string {
 		}
 		i++
 	}
-	return strings.Repeat(" ", i) + "\u25B3"
+	return strings.Repeat(" ", DisplayWidth(string(ar[:i]))) + "\u25B3"
 }
 
 func showWhitespaces(orig string) string {
@@ -137,19 +132,19 @@ func computeDiff(expected string, actual string) DiffResult {
 		actualRunes[i] = []rune(line)
 	}
 
-	// Calculate maximum width for both columns based on visible characters
-	expectedWidth := utf8.RuneCountInString("Expected")
+	// Calculate maximum width for both columns based on the display width of visible characters
+	expectedWidth := DisplayWidth("Expected")
 	for _, s := range expectedArr {
 		visible := showWhitespaces(s)
-		if w := utf8.RuneCountInString(visible); w > expectedWidth {
+		if w := DisplayWidth(visible); w > expectedWidth {
 			expectedWidth = w
 		}
 	}
 
-	actualWidth := utf8.RuneCountInString("Actual")
+	actualWidth := DisplayWidth("Actual")
 	for _, s := range actualArr {
 		visible := showWhitespaces(s)
-		if w := utf8.RuneCountInString(visible); w > actualWidth {
+		if w := DisplayWidth(visible); w > actualWidth {
 			actualWidth = w
 		}
 	}
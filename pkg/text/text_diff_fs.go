@@ -0,0 +1,197 @@
+package text
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+)
+
+// FSDiffOptions configures which files DiffFSWithOptions compares.
+//
+// Patterns use path.Match syntax. A pattern without a slash is matched against every element of a file path, so
+// "*.go" selects Go files in any directory and "vendor" selects everything below a vendor directory. A pattern with
+// a slash is matched against the full slash-separated path and its parent directories.
+type FSDiffOptions struct {
+	// Include limits the comparison to files matching at least one pattern, all files when empty
+	Include []string
+	// Exclude removes files matching any pattern from the comparison
+	Exclude []string
+}
+
+// fsDiffEntry is the comparison outcome for a single file
+type fsDiffEntry struct {
+	path     string
+	status   DiffStatus
+	expected string
+	actual   string
+}
+
+// DiffFS compares two file trees and returns a summary with per-file diffs, a boolean value to indicate if the
+// trees matched, and any error encountered while reading them
+func DiffFS(expected, actual fs.FS) (string, bool, error) {
+	return DiffFSWithOptions(expected, actual, FSDiffOptions{})
+}
+
+// DiffFSWithOptions compares the files of two trees selected by the include and exclude patterns. Files only in
+// expected are reported as removed (←), files only in actual as added (→), and files with different content as
+// changed (≠) followed by their Diff output.
+func DiffFSWithOptions(expected, actual fs.FS, opts FSDiffOptions) (string, bool, error) {
+	for _, pattern := range append(append([]string{}, opts.Include...), opts.Exclude...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return "", false, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+	}
+
+	expectedFiles, err := readTree(expected, opts)
+	if err != nil {
+		return "", false, fmt.Errorf("reading expected tree: %w", err)
+	}
+	actualFiles, err := readTree(actual, opts)
+	if err != nil {
+		return "", false, fmt.Errorf("reading actual tree: %w", err)
+	}
+
+	entries := compareTrees(expectedFiles, actualFiles)
+	output, match := renderFSDiff(entries)
+	return output, match, nil
+}
+
+// readTree reads all selected regular files of a tree keyed by their slash-separated path
+func readTree(fsys fs.FS, opts FSDiffOptions) (map[string]string, error) {
+	files := map[string]string{}
+	err := fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !selected(name, opts) {
+			return nil
+		}
+		content, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return err
+		}
+		files[name] = string(content)
+		return nil
+	})
+	return files, err
+}
+
+// selected reports whether a file path passes the include and exclude patterns
+func selected(name string, opts FSDiffOptions) bool {
+	if len(opts.Include) > 0 && !matchesAny(opts.Include, name) {
+		return false
+	}
+	return !matchesAny(opts.Exclude, name)
+}
+
+// matchesAny reports whether any pattern matches the file path
+func matchesAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if matchesPattern(pattern, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesPattern matches a pattern against a path element or a path prefix, as described on FSDiffOptions
+func matchesPattern(pattern, name string) bool {
+	elements := strings.Split(name, "/")
+
+	if !strings.Contains(pattern, "/") {
+		for _, element := range elements {
+			if ok, _ := path.Match(pattern, element); ok {
+				return true
+			}
+		}
+		return false
+	}
+
+	for i := range elements {
+		if ok, _ := path.Match(pattern, strings.Join(elements[:i+1], "/")); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// compareTrees classifies every file of both trees, sorted by path
+func compareTrees(expected, actual map[string]string) []fsDiffEntry {
+	var names []string
+	for name := range expected {
+		names = append(names, name)
+	}
+	for name := range actual {
+		if _, ok := expected[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	entries := make([]fsDiffEntry, 0, len(names))
+	for _, name := range names {
+		e, inExpected := expected[name]
+		a, inActual := actual[name]
+
+		status := DiffStatusEqual
+		switch {
+		case !inActual:
+			status = DiffStatusMissingInActual
+		case !inExpected:
+			status = DiffStatusMissingInExpected
+		case e != a:
+			status = DiffStatusDifferent
+		}
+		entries = append(entries, fsDiffEntry{path: name, status: status, expected: e, actual: a})
+	}
+
+	return entries
+}
+
+// renderFSDiff renders the summary header, the list of differing files and the per-file diffs
+func renderFSDiff(entries []fsDiffEntry) (string, bool) {
+	var added, removed, changed, unchanged int
+	for _, e := range entries {
+		switch e.status {
+		case DiffStatusMissingInExpected:
+			added++
+		case DiffStatusMissingInActual:
+			removed++
+		case DiffStatusDifferent:
+			changed++
+		default:
+			unchanged++
+		}
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Summary: %d added, %d removed, %d changed, %d unchanged\n",
+		added, removed, changed, unchanged))
+
+	for _, e := range entries {
+		switch e.status {
+		case DiffStatusMissingInExpected:
+			sb.WriteString("→ " + e.path + "\n")
+		case DiffStatusMissingInActual:
+			sb.WriteString("← " + e.path + "\n")
+		case DiffStatusDifferent:
+			sb.WriteString("≠ " + e.path + "\n")
+		}
+	}
+
+	for _, e := range entries {
+		if e.status != DiffStatusDifferent {
+			continue
+		}
+		diff, _ := Diff(e.expected, e.actual)
+		sb.WriteString("\n≠ " + e.path + "\n")
+		sb.WriteString(diff)
+		if !strings.HasSuffix(diff, "\n") {
+			sb.WriteString("\n")
+		}
+	}
+
+	return sb.String(), added+removed+changed == 0
+}
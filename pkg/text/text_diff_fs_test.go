@@ -0,0 +1,182 @@
+package text_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/shapestone/textsmith/pkg/text"
+)
+
+func TestDiffFS_WithIdenticalTrees_ReturnsMatch(t *testing.T) {
+	// Given
+	expected := fstest.MapFS{
+		"main.go":     {Data: []byte("package main\n")},
+		"sub/util.go": {Data: []byte("package sub\n")},
+	}
+	actual := fstest.MapFS{
+		"main.go":     {Data: []byte("package main\n")},
+		"sub/util.go": {Data: []byte("package sub\n")},
+	}
+
+	// When
+	output, match, err := text.DiffFS(expected, actual)
+
+	// Then
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !match {
+		t.Fatalf("Expected trees to match, got:\n%s", output)
+	}
+
+	expectedOutput := "Summary: 0 added, 0 removed, 0 changed, 2 unchanged\n"
+	if output != expectedOutput {
+		t.Fatalf("Expected %q, got %q", expectedOutput, output)
+	}
+}
+
+func TestDiffFS_WithAddedRemovedAndChangedFiles_ReportsEachFile(t *testing.T) {
+	// Given
+	expected := fstest.MapFS{
+		"keep.txt":   {Data: []byte("same")},
+		"old.txt":    {Data: []byte("gone")},
+		"a/conf.txt": {Data: []byte("port=80")},
+	}
+	actual := fstest.MapFS{
+		"keep.txt":   {Data: []byte("same")},
+		"new.txt":    {Data: []byte("fresh")},
+		"a/conf.txt": {Data: []byte("port=81")},
+	}
+
+	// When
+	output, match, err := text.DiffFS(expected, actual)
+
+	// Then
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if match {
+		t.Fatalf("Expected trees not to match")
+	}
+
+	expectedOutput := text.StripColumn(`
+		|Summary: 1 added, 1 removed, 1 changed, 1 unchanged|
+		|≠ a/conf.txt|
+		|→ new.txt|
+		|← old.txt|
+		||
+		|≠ a/conf.txt|
+		|Expected | Actual  |
+		|-------- | --------|
+		|port=80  ≠ port=81 |
+		|      △          △ |
+		||
+	`)
+	if output != expectedOutput {
+		diff, _ := text.Diff(expectedOutput, output)
+		t.Fatalf("Rendered output does not match expected:\n\n%s", diff)
+	}
+}
+
+func TestDiffFSWithOptions_WithIncludePattern_ComparesOnlyMatchingFiles(t *testing.T) {
+	// Given
+	expected := fstest.MapFS{
+		"main.go":     {Data: []byte("package main")},
+		"README.md":   {Data: []byte("old docs")},
+		"sub/util.go": {Data: []byte("package sub")},
+	}
+	actual := fstest.MapFS{
+		"main.go":     {Data: []byte("package main")},
+		"README.md":   {Data: []byte("new docs")},
+		"sub/util.go": {Data: []byte("package sub")},
+	}
+
+	// When
+	output, match, err := text.DiffFSWithOptions(expected, actual, text.FSDiffOptions{Include: []string{"*.go"}})
+
+	// Then
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !match {
+		t.Fatalf("Expected trees to match when only Go files are compared, got:\n%s", output)
+	}
+	if !strings.HasPrefix(output, "Summary: 0 added, 0 removed, 0 changed, 2 unchanged") {
+		t.Fatalf("Unexpected summary: %q", output)
+	}
+}
+
+func TestDiffFSWithOptions_WithExcludedDirectory_SkipsFilesBelowIt(t *testing.T) {
+	// Given
+	expected := fstest.MapFS{
+		"main.go":          {Data: []byte("package main")},
+		"gen/cache/a.json": {Data: []byte("{}")},
+	}
+	actual := fstest.MapFS{
+		"main.go":          {Data: []byte("package main")},
+		"gen/cache/b.json": {Data: []byte("{}")},
+	}
+
+	// When
+	output, match, err := text.DiffFSWithOptions(expected, actual, text.FSDiffOptions{Exclude: []string{"gen/cache"}})
+
+	// Then
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !match {
+		t.Fatalf("Expected excluded directory to be ignored, got:\n%s", output)
+	}
+}
+
+func TestDiffFSWithOptions_WithInvalidPattern_ReturnsError(t *testing.T) {
+	// Given
+	tree := fstest.MapFS{"a.txt": {Data: []byte("a")}}
+
+	// When
+	_, _, err := text.DiffFSWithOptions(tree, tree, text.FSDiffOptions{Include: []string{"[a-"}})
+
+	// Then
+	if err == nil {
+		t.Fatalf("Expected an error for a malformed pattern")
+	}
+}
+
+func TestDiffFS_WithDirFSAgainstMapFS_ComparesAcrossImplementations(t *testing.T) {
+	// Given
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "out"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "out", "gen.txt"), []byte("generated\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	expected := fstest.MapFS{"out/gen.txt": {Data: []byte("generated\n")}}
+
+	// When
+	output, match, err := text.DiffFS(expected, os.DirFS(dir))
+
+	// Then
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !match {
+		t.Fatalf("Expected trees to match, got:\n%s", output)
+	}
+}
+
+func TestDiffFS_WithUnreadableRoot_ReturnsError(t *testing.T) {
+	// Given
+	missing := os.DirFS(filepath.Join(t.TempDir(), "does-not-exist"))
+
+	// When
+	_, _, err := text.DiffFS(fstest.MapFS{}, missing)
+
+	// Then
+	if err == nil {
+		t.Fatalf("Expected an error for a missing directory")
+	}
+}
@@ -283,6 +283,27 @@ func TestDiff_RenderLogic_WithUnicodeContent_MaintainsAlignment(t *testing.T) {
 	}
 }
 
+func TestDiff_RenderLogic_WithWideCharacters_AlignsByDisplayWidth(t *testing.T) {
+	// Given
+	expected := "名前は世界"
+	actual := "名前は地球"
+
+	// When
+	diffOutput, _ := Diff(expected, actual)
+
+	// Then
+	expectedOutput := StripColumn(`
+		|Expected   | Actual    |
+		|---------- | ----------|
+		|名前は世界 ≠ 名前は地球|
+		|      △            △   |
+	`)
+
+	if diffOutput != expectedOutput {
+		t.Fatalf("Rendered output does not match expected:\n\n%s", compareMultilineStrings(diffOutput, expectedOutput))
+	}
+}
+
 func TestDiff_RenderLogic_WithEmptyStrings_ShowsHeaderOnly(t *testing.T) {
 	// Given
 	expected := ""
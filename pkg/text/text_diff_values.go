@@ -0,0 +1,426 @@
+package text
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+	"unsafe"
+)
+
+// UnexportedFields controls how unexported struct fields are rendered by FormatValue
+type UnexportedFields int
+
+const (
+	// UnexportedInclude renders unexported fields like exported ones
+	UnexportedInclude UnexportedFields = iota
+	// UnexportedOmit leaves unexported fields out of the rendering
+	UnexportedOmit
+)
+
+// BytesFormat controls how []byte values are rendered by FormatValue
+type BytesFormat int
+
+const (
+	// BytesAuto renders printable UTF-8 byte slices as strings and everything else as hex
+	BytesAuto BytesFormat = iota
+	// BytesHex always renders byte slices as hex
+	BytesHex
+)
+
+// ValueOptions configures the pretty-printer used by FormatValue and DiffValues
+type ValueOptions struct {
+	// Unexported selects how unexported struct fields are handled
+	Unexported UnexportedFields
+	// TimeFormat is the layout used for time.Time values, time.RFC3339Nano when empty
+	TimeFormat string
+	// Bytes selects how []byte values are rendered
+	Bytes BytesFormat
+	// Indent is the indentation unit for nested values, two spaces when empty
+	Indent string
+}
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+)
+
+// valuePrinter renders Go values into a deterministic multi-line representation
+type valuePrinter struct {
+	opts    ValueOptions
+	sb      strings.Builder
+	visited map[visitKey]bool
+}
+
+// visitKey identifies a reference on the current rendering path for cycle detection
+type visitKey struct {
+	ptr uintptr
+	typ reflect.Type
+}
+
+// FormatValue pretty-prints a Go value with default options
+func FormatValue(v any) string {
+	return FormatValueWithOptions(v, ValueOptions{})
+}
+
+// FormatValueWithOptions pretty-prints a Go value into a deterministic, Go-like multi-line representation.
+// Map keys are sorted, pointer cycles are reported as <cycle>, and time.Time and []byte values are formatted
+// according to the options.
+func FormatValueWithOptions(v any, opts ValueOptions) string {
+	if opts.TimeFormat == "" {
+		opts.TimeFormat = time.RFC3339Nano
+	}
+	if opts.Indent == "" {
+		opts.Indent = "  "
+	}
+
+	p := &valuePrinter{opts: opts, visited: map[visitKey]bool{}}
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() {
+		return "nil"
+	}
+
+	p.print(addressable(rv), 0, true)
+
+	return p.sb.String()
+}
+
+// DiffValues pretty-prints two Go values and compares the renderings with Diff
+func DiffValues(expected, actual any) (string, bool) {
+	return DiffValuesWithOptions(expected, actual, ValueOptions{})
+}
+
+// DiffValuesWithOptions pretty-prints two Go values with the given options and compares the renderings with Diff
+func DiffValuesWithOptions(expected, actual any, opts ValueOptions) (string, bool) {
+	return Diff(FormatValueWithOptions(expected, opts), FormatValueWithOptions(actual, opts))
+}
+
+// exposed returns a value whose contents can be used through Interface, even when it was reached through an
+// unexported field
+func exposed(v reflect.Value) reflect.Value {
+	if v.CanInterface() || !v.CanAddr() {
+		return v
+	}
+	return reflect.NewAt(v.Type(), unsafe.Pointer(v.UnsafeAddr())).Elem()
+}
+
+// addressable copies v into addressable memory, so exposed can read the unexported fields it contains. Values
+// that are stored in maps or interfaces, or passed by value, are not addressable themselves.
+func addressable(v reflect.Value) reflect.Value {
+	if v.CanAddr() || !v.CanInterface() {
+		return v
+	}
+	c := reflect.New(v.Type()).Elem()
+	c.Set(v)
+	return c
+}
+
+// print writes the rendering of v at the given nesting depth. The typed flag is set when the static type of the
+// surrounding context does not already tell the reader which type v has.
+func (p *valuePrinter) print(v reflect.Value, depth int, typed bool) {
+	v = exposed(v)
+	t := v.Type()
+
+	switch {
+	case t == timeType && v.CanInterface():
+		tm := v.Interface().(time.Time)
+		p.sb.WriteString("time.Time(" + tm.Format(p.opts.TimeFormat) + ")")
+		return
+	case t == durationType:
+		p.sb.WriteString("time.Duration(" + time.Duration(v.Int()).String() + ")")
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			p.sb.WriteString("nil")
+			return
+		}
+		p.print(addressable(v.Elem()), depth, true)
+	case reflect.Pointer:
+		p.printPointer(v, depth)
+	case reflect.Struct:
+		p.printStruct(v, depth)
+	case reflect.Map:
+		p.printMap(v, depth)
+	case reflect.Slice:
+		if v.IsNil() {
+			p.sb.WriteString(t.String() + "(nil)")
+			return
+		}
+		if t.Elem().Kind() == reflect.Uint8 {
+			p.printBytes(v)
+			return
+		}
+		key := visitKey{ptr: v.Pointer(), typ: t}
+		if v.Len() > 0 && p.visited[key] {
+			p.sb.WriteString("<cycle>")
+			return
+		}
+		p.visited[key] = true
+		p.printList(v, depth)
+		delete(p.visited, key)
+	case reflect.Array:
+		p.printList(v, depth)
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		if v.IsNil() {
+			p.sb.WriteString(t.String() + "(nil)")
+			return
+		}
+		// Addresses are not deterministic, so only the type is shown
+		p.sb.WriteString("<" + t.String() + ">")
+	default:
+		p.printScalar(v, typed)
+	}
+}
+
+// printPointer renders a pointer as &T{...} while guarding against cycles
+func (p *valuePrinter) printPointer(v reflect.Value, depth int) {
+	if v.IsNil() {
+		p.sb.WriteString("(" + v.Type().String() + ")(nil)")
+		return
+	}
+
+	key := visitKey{ptr: v.Pointer(), typ: v.Type()}
+	if p.visited[key] {
+		p.sb.WriteString("<cycle>")
+		return
+	}
+	p.visited[key] = true
+	defer delete(p.visited, key)
+
+	p.sb.WriteString("&")
+	p.print(v.Elem(), depth, true)
+}
+
+// printStruct renders a struct with one field per line
+func (p *valuePrinter) printStruct(v reflect.Value, depth int) {
+	t := v.Type()
+	var fields []int
+	for i := 0; i < t.NumField(); i++ {
+		if !t.Field(i).IsExported() && p.opts.Unexported == UnexportedOmit {
+			continue
+		}
+		fields = append(fields, i)
+	}
+
+	p.sb.WriteString(t.String() + "{")
+	if len(fields) == 0 {
+		p.sb.WriteString("}")
+		return
+	}
+
+	p.sb.WriteString("\n")
+	for _, i := range fields {
+		p.indent(depth + 1)
+		p.sb.WriteString(t.Field(i).Name + ": ")
+		p.print(v.Field(i), depth+1, t.Field(i).Type.Kind() == reflect.Interface)
+		p.sb.WriteString(",\n")
+	}
+	p.indent(depth)
+	p.sb.WriteString("}")
+}
+
+// printMap renders a map with its keys in a deterministic order
+func (p *valuePrinter) printMap(v reflect.Value, depth int) {
+	t := v.Type()
+	if v.IsNil() {
+		p.sb.WriteString(t.String() + "(nil)")
+		return
+	}
+
+	key := visitKey{ptr: v.Pointer(), typ: t}
+	if p.visited[key] {
+		p.sb.WriteString("<cycle>")
+		return
+	}
+	p.visited[key] = true
+	defer delete(p.visited, key)
+
+	p.sb.WriteString(t.String() + "{")
+	if v.Len() == 0 {
+		p.sb.WriteString("}")
+		return
+	}
+
+	// Values are rendered from the iterator, as looking them up again fails for keys that are not equal to
+	// themselves, such as NaN. Keys that tie, like several NaN keys, are ordered by their rendered values.
+	type entry struct {
+		key      reflect.Value
+		rendered string
+		value    string
+	}
+	var entries []entry
+	iter := v.MapRange()
+	for iter.Next() {
+		kp := &valuePrinter{opts: p.opts, visited: p.visited}
+		kp.print(iter.Key(), depth+1, t.Key().Kind() == reflect.Interface)
+		vp := &valuePrinter{opts: p.opts, visited: p.visited}
+		vp.print(addressable(iter.Value()), depth+1, t.Elem().Kind() == reflect.Interface)
+		entries = append(entries, entry{key: iter.Key(), rendered: kp.sb.String(), value: vp.sb.String()})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if lessKey(a.key, b.key, a.rendered, b.rendered) {
+			return true
+		}
+		if lessKey(b.key, a.key, b.rendered, a.rendered) {
+			return false
+		}
+		return a.value < b.value
+	})
+
+	p.sb.WriteString("\n")
+	for _, e := range entries {
+		p.indent(depth + 1)
+		p.sb.WriteString(e.rendered + ": " + e.value + ",\n")
+	}
+	p.indent(depth)
+	p.sb.WriteString("}")
+}
+
+// lessKey orders map keys naturally when they share a basic kind and by their rendering otherwise. NaN keys come
+// after every number.
+func lessKey(a, b reflect.Value, ra, rb string) bool {
+	a, b = exposed(a), exposed(b)
+	if a.Kind() == reflect.Interface && !a.IsNil() {
+		a = a.Elem()
+	}
+	if b.Kind() == reflect.Interface && !b.IsNil() {
+		b = b.Elem()
+	}
+
+	if a.Kind() == b.Kind() {
+		switch a.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			if a.Int() != b.Int() {
+				return a.Int() < b.Int()
+			}
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			if a.Uint() != b.Uint() {
+				return a.Uint() < b.Uint()
+			}
+		case reflect.Float32, reflect.Float64:
+			aNaN, bNaN := math.IsNaN(a.Float()), math.IsNaN(b.Float())
+			if aNaN != bNaN {
+				return bNaN
+			}
+			if !aNaN && a.Float() != b.Float() {
+				return a.Float() < b.Float()
+			}
+		case reflect.String:
+			if a.String() != b.String() {
+				return a.String() < b.String()
+			}
+		case reflect.Bool:
+			if a.Bool() != b.Bool() {
+				return !a.Bool()
+			}
+		}
+	}
+
+	return ra < rb
+}
+
+// printList renders slices and arrays with one element per line
+func (p *valuePrinter) printList(v reflect.Value, depth int) {
+	t := v.Type()
+	p.sb.WriteString(t.String() + "{")
+	if v.Len() == 0 {
+		p.sb.WriteString("}")
+		return
+	}
+
+	p.sb.WriteString("\n")
+	for i := 0; i < v.Len(); i++ {
+		p.indent(depth + 1)
+		p.print(v.Index(i), depth+1, t.Elem().Kind() == reflect.Interface)
+		p.sb.WriteString(",\n")
+	}
+	p.indent(depth)
+	p.sb.WriteString("}")
+}
+
+// printBytes renders a byte slice as a string when it is printable text, or as hex otherwise
+func (p *valuePrinter) printBytes(v reflect.Value) {
+	b := make([]byte, v.Len())
+	for i := range b {
+		b[i] = byte(v.Index(i).Uint())
+	}
+
+	if p.opts.Bytes == BytesAuto && utf8.Valid(b) && isPrintableText(string(b)) {
+		p.sb.WriteString(v.Type().String() + "(" + strconv.Quote(string(b)) + ")")
+		return
+	}
+
+	p.sb.WriteString(v.Type().String() + "{")
+	for i, c := range b {
+		if i > 0 {
+			p.sb.WriteString(", ")
+		}
+		p.sb.WriteString(fmt.Sprintf("0x%02x", c))
+	}
+	p.sb.WriteString("}")
+}
+
+// isPrintableText reports whether a string contains only printable characters and common whitespace
+func isPrintableText(s string) bool {
+	for _, r := range s {
+		if r == '\n' || r == '\t' || r == '\r' {
+			continue
+		}
+		if !strconv.IsPrint(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// printScalar renders booleans, numbers and strings, adding the type name when it is not implied
+func (p *valuePrinter) printScalar(v reflect.Value, typed bool) {
+	t := v.Type()
+	var s string
+	switch v.Kind() {
+	case reflect.Bool:
+		s = strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		s = strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		s = strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32:
+		s = strconv.FormatFloat(v.Float(), 'g', -1, 32)
+	case reflect.Float64:
+		s = strconv.FormatFloat(v.Float(), 'g', -1, 64)
+	case reflect.Complex64, reflect.Complex128:
+		s = fmt.Sprint(v.Complex())
+	case reflect.String:
+		s = strconv.Quote(v.String())
+	default:
+		s = "<" + t.String() + ">"
+	}
+
+	// Named types and non-default types in interfaces are wrapped in a conversion
+	if t.PkgPath() != "" || (typed && !isDefaultType(t)) {
+		s = t.String() + "(" + s + ")"
+	}
+	p.sb.WriteString(s)
+}
+
+// isDefaultType reports whether t is the type an untyped constant of its kind defaults to
+func isDefaultType(t reflect.Type) bool {
+	switch t {
+	case reflect.TypeOf(false), reflect.TypeOf(0), reflect.TypeOf(0.0), reflect.TypeOf(""):
+		return true
+	}
+	return false
+}
+
+// indent writes the indentation for the given depth
+func (p *valuePrinter) indent(depth int) {
+	p.sb.WriteString(strings.Repeat(p.opts.Indent, depth))
+}
@@ -0,0 +1,335 @@
+package text_test
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shapestone/textsmith/pkg/text"
+)
+
+type valueAddress struct {
+	City string
+	Zip  int
+}
+
+type valuePerson struct {
+	Name    string
+	Age     int
+	Tags    []string
+	Address *valueAddress
+	secret  string
+}
+
+type valueNode struct {
+	Name string
+	Next *valueNode
+}
+
+type valueEvent struct {
+	At      time.Time
+	Timeout time.Duration
+	Payload []byte
+	created time.Time
+}
+
+func TestFormatValue_WithStruct_RendersOneFieldPerLine(t *testing.T) {
+	// Given
+	person := valuePerson{
+		Name:    "Ann",
+		Age:     42,
+		Tags:    []string{"a", "b"},
+		Address: &valueAddress{City: "Oslo", Zip: 150},
+		secret:  "s3cr3t",
+	}
+
+	// When
+	result := text.FormatValue(person)
+
+	// Then
+	expected := text.StripMargin(`
+		|text_test.valuePerson{
+		|  Name: "Ann",
+		|  Age: 42,
+		|  Tags: []string{
+		|    "a",
+		|    "b",
+		|  },
+		|  Address: &text_test.valueAddress{
+		|    City: "Oslo",
+		|    Zip: 150,
+		|  },
+		|  secret: "s3cr3t",
+		|}`)
+	if result != expected {
+		diff, _ := text.Diff(expected, result)
+		t.Fatalf("Rendered value does not match expected:\n\n%s", diff)
+	}
+}
+
+func TestFormatValue_WithMap_SortsKeys(t *testing.T) {
+	// Given
+	m := map[string]int{"zeta": 1, "alpha": 2, "mid": 3}
+
+	// When
+	result := text.FormatValue(m)
+
+	// Then
+	expected := text.StripMargin(`
+		|map[string]int{
+		|  "alpha": 2,
+		|  "mid": 3,
+		|  "zeta": 1,
+		|}`)
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestFormatValue_WithIntegerMapKeys_SortsNumerically(t *testing.T) {
+	// Given
+	m := map[int]string{10: "ten", 2: "two", -1: "minus one"}
+
+	// When
+	result := text.FormatValue(m)
+
+	// Then
+	expected := text.StripMargin(`
+		|map[int]string{
+		|  -1: "minus one",
+		|  2: "two",
+		|  10: "ten",
+		|}`)
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestFormatValue_WithPointerCycle_ReportsCycle(t *testing.T) {
+	// Given
+	a := &valueNode{Name: "a"}
+	b := &valueNode{Name: "b", Next: a}
+	a.Next = b
+
+	// When
+	result := text.FormatValue(a)
+
+	// Then
+	expected := text.StripMargin(`
+		|&text_test.valueNode{
+		|  Name: "a",
+		|  Next: &text_test.valueNode{
+		|    Name: "b",
+		|    Next: <cycle>,
+		|  },
+		|}`)
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestFormatValue_WithSharedPointerWithoutCycle_RendersBothOccurrences(t *testing.T) {
+	// Given
+	shared := &valueAddress{City: "Rome"}
+	pair := []*valueAddress{shared, shared}
+
+	// When
+	result := text.FormatValue(pair)
+
+	// Then
+	if strings.Contains(result, "<cycle>") {
+		t.Fatalf("Expected shared pointers not to be reported as cycles, got %q", result)
+	}
+	if strings.Count(result, `"Rome"`) != 2 {
+		t.Fatalf("Expected both occurrences to be rendered, got %q", result)
+	}
+}
+
+func TestFormatValueWithOptions_WithUnexportedOmit_LeavesOutUnexportedFields(t *testing.T) {
+	// Given
+	person := valuePerson{Name: "Ann", secret: "s3cr3t"}
+
+	// When
+	result := text.FormatValueWithOptions(person, text.ValueOptions{Unexported: text.UnexportedOmit})
+
+	// Then
+	if strings.Contains(result, "secret") {
+		t.Fatalf("Expected unexported field to be omitted, got %q", result)
+	}
+	if !strings.Contains(result, `Name: "Ann"`) {
+		t.Fatalf("Expected exported field to be rendered, got %q", result)
+	}
+}
+
+func TestFormatValue_WithTimeDurationAndBytes_UsesReadableFormats(t *testing.T) {
+	// Given
+	at := time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC)
+	event := valueEvent{
+		At:      at,
+		Timeout: 1500 * time.Millisecond,
+		Payload: []byte("hello"),
+		created: at,
+	}
+
+	// When
+	result := text.FormatValue(event)
+
+	// Then
+	expected := text.StripMargin(`
+		|text_test.valueEvent{
+		|  At: time.Time(2024-05-01T12:30:00Z),
+		|  Timeout: time.Duration(1.5s),
+		|  Payload: []uint8("hello"),
+		|  created: time.Time(2024-05-01T12:30:00Z),
+		|}`)
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestFormatValue_WithStructsInMap_FormatsUnexportedTimeFields(t *testing.T) {
+	// Given
+	at := time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC)
+	events := map[string]valueEvent{"deploy": {created: at}}
+
+	// When
+	result := text.FormatValueWithOptions(events, text.ValueOptions{TimeFormat: time.DateOnly})
+
+	// Then
+	expected := text.StripMargin(`
+		|map[string]text_test.valueEvent{
+		|  "deploy": text_test.valueEvent{
+		|    At: time.Time(0001-01-01),
+		|    Timeout: time.Duration(0s),
+		|    Payload: []uint8(nil),
+		|    created: time.Time(2024-05-01),
+		|  },
+		|}`)
+	if diff, ok := text.Diff(expected, result); !ok {
+		t.Fatalf("Output mismatch:\n%s", diff)
+	}
+}
+
+func TestFormatValue_WithNaNMapKeys_SortsThemAfterNumbersByValue(t *testing.T) {
+	// Given
+	values := map[float64]int{math.NaN(): 3, math.Inf(1): 6, math.NaN(): 1, -1: 5, math.NaN(): 2, 2.5: 4}
+
+	for i := 0; i < 20; i++ {
+		// When
+		result := text.FormatValue(values)
+
+		// Then
+		expected := text.StripMargin(`
+			|map[float64]int{
+			|  -1: 5,
+			|  2.5: 4,
+			|  +Inf: 6,
+			|  NaN: 1,
+			|  NaN: 2,
+			|  NaN: 3,
+			|}`)
+		if diff, ok := text.Diff(expected, result); !ok {
+			t.Fatalf("Output mismatch:\n%s", diff)
+		}
+	}
+}
+
+func TestFormatValueWithOptions_WithCustomTimeFormatAndHexBytes_UsesOptions(t *testing.T) {
+	// Given
+	event := valueEvent{
+		At:      time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+		Payload: []byte{0x00, 0xff},
+	}
+	opts := text.ValueOptions{TimeFormat: time.DateOnly, Bytes: text.BytesHex, Unexported: text.UnexportedOmit}
+
+	// When
+	result := text.FormatValueWithOptions(event, opts)
+
+	// Then
+	if !strings.Contains(result, "At: time.Time(2024-05-01)") {
+		t.Errorf("Expected custom time format, got %q", result)
+	}
+	if !strings.Contains(result, "Payload: []uint8{0x00, 0xff}") {
+		t.Errorf("Expected hex bytes, got %q", result)
+	}
+}
+
+func TestFormatValue_WithInterfaceValues_ShowsNonDefaultTypes(t *testing.T) {
+	// Given
+	values := []any{1, int64(2), "three", nil}
+
+	// When
+	result := text.FormatValue(values)
+
+	// Then
+	expected := text.StripMargin(`
+		|[]interface {}{
+		|  1,
+		|  int64(2),
+		|  "three",
+		|  nil,
+		|}`)
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestFormatValue_WithNilValues_RendersTypedNil(t *testing.T) {
+	// Given
+	var p *valueAddress
+	var s []string
+	var m map[string]int
+
+	// When
+	results := []string{text.FormatValue(nil), text.FormatValue(p), text.FormatValue(s), text.FormatValue(m)}
+
+	// Then
+	expected := []string{"nil", "(*text_test.valueAddress)(nil)", "[]string(nil)", "map[string]int(nil)"}
+	for i := range expected {
+		if results[i] != expected[i] {
+			t.Errorf("Expected %q, got %q", expected[i], results[i])
+		}
+	}
+}
+
+func TestDiffValues_WithEqualValues_ReturnsMatch(t *testing.T) {
+	// Given
+	expected := map[string][]int{"a": {1, 2}, "b": {3}}
+	actual := map[string][]int{"b": {3}, "a": {1, 2}}
+
+	// When
+	_, match := text.DiffValues(expected, actual)
+
+	// Then
+	if !match {
+		t.Fatalf("Expected DiffValues to return true for equal values")
+	}
+}
+
+func TestDiffValues_WithDifferentField_ShowsDifferingLine(t *testing.T) {
+	// Given
+	expected := valuePerson{Name: "Ann", Age: 42}
+	actual := valuePerson{Name: "Ann", Age: 43}
+
+	// When
+	diffOutput, match := text.DiffValues(expected, actual)
+
+	// Then
+	if match {
+		t.Fatalf("Expected DiffValues to return false for different values")
+	}
+
+	expectedOutput := text.StripColumn(`
+		|Expected                                   | Actual                                    |
+		|------------------------------------------ | ------------------------------------------|
+		|text_test.valuePerson{                     | text_test.valuePerson{                    |
+		|␣␣Name:␣"Ann",                             | ␣␣Name:␣"Ann",                            |
+		|␣␣Age:␣42,                                 ≠ ␣␣Age:␣43,                                |
+		|        △                                            △                                 |
+	`)
+	if diffOutput != expectedOutput {
+		diff, _ := text.Diff(expectedOutput, diffOutput)
+		t.Fatalf("Rendered output does not match expected:\n\n%s", diff)
+	}
+}
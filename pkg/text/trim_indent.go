@@ -0,0 +1,125 @@
+package text
+
+import "strings"
+
+// DefaultTabWidth is the number of columns a tab advances to when no tab width is configured
+const DefaultTabWidth = 4
+
+// IndentOptions configures the indentation helpers
+type IndentOptions struct {
+	// TabWidth is the distance between tab stops used to measure indentation, DefaultTabWidth when zero
+	TabWidth int
+	// SkipBlankLines leaves lines containing only spaces and tabs unchanged in Indent, Outdent and Reindent
+	SkipBlankLines bool
+	// SkipFirstLine leaves the first line unchanged in Indent, Outdent and Reindent, for snippets whose first
+	// line starts after other text, e.g. a value inserted at the end of a line
+	SkipFirstLine bool
+}
+
+// tabWidth returns the configured tab width or the default
+func (o IndentOptions) tabWidth() int {
+	if o.TabWidth <= 0 {
+		return DefaultTabWidth
+	}
+	return o.TabWidth
+}
+
+// The TrimIndent function removes the common leading indentation of all non-blank lines, so multiline strings
+// can be indented with the surrounding code without margin pipes. A blank first and last line are dropped, and
+// the relative indentation of the remaining lines is kept.
+//
+// Code example:
+//
+//	text.TrimIndent(`
+//	    func Example() {
+//	        return nil
+//	    }
+//	`)
+func TrimIndent(s string) string {
+	return TrimIndentWithOptions(s, IndentOptions{})
+}
+
+// TrimIndentWithOptions works like TrimIndent with a configurable tab width. Indentation is measured in columns:
+// a tab advances to the next tab stop. When the common indentation ends inside a tab, the rest of that tab is
+// replaced by spaces so the relative indentation is kept.
+func TrimIndentWithOptions(s string, opts IndentOptions) string {
+	if s == "" {
+		return ""
+	}
+
+	tabWidth := opts.tabWidth()
+	lines := strings.Split(s, "\n")
+
+	// Drop a leading and a trailing blank line, typically left by the backticks of a raw string
+	if len(lines) > 0 && isBlankLine(lines[0]) {
+		lines = lines[1:]
+	}
+	if len(lines) > 0 && isBlankLine(lines[len(lines)-1]) {
+		lines = lines[:len(lines)-1]
+	}
+
+	minIndent := -1
+	for _, line := range lines {
+		if isBlankLine(line) {
+			continue
+		}
+		if indent := indentWidth(line, tabWidth); minIndent < 0 || indent < minIndent {
+			minIndent = indent
+		}
+	}
+	if minIndent < 0 {
+		minIndent = 0
+	}
+
+	for i, line := range lines {
+		lines[i] = cutIndent(line, minIndent, tabWidth)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// isBlankLine reports whether a line contains only spaces, tabs and a line ending carriage return
+func isBlankLine(line string) bool {
+	return strings.Trim(line, " \t\r") == ""
+}
+
+// indentWidth returns the width in columns of the leading spaces and tabs of a line
+func indentWidth(line string, tabWidth int) int {
+	column := 0
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case ' ':
+			column++
+		case '\t':
+			column += tabWidth - column%tabWidth
+		default:
+			return column
+		}
+	}
+	return column
+}
+
+// cutIndent removes up to n columns of leading spaces and tabs from a line. When the cut is not at a tab stop,
+// the remaining indentation is written as spaces, as a tab crossing the cut or following it would no longer
+// reach the same column.
+func cutIndent(line string, n int, tabWidth int) string {
+	content := strings.TrimLeft(line, " \t")
+	width := indentWidth(line, tabWidth)
+	if width <= n {
+		return content
+	}
+	if n%tabWidth != 0 && strings.Contains(line[:len(line)-len(content)], "\t") {
+		return strings.Repeat(" ", width-n) + content
+	}
+
+	column := 0
+	i := 0
+	for ; column < n; i++ {
+		if line[i] == '\t' {
+			column += tabWidth - column%tabWidth
+		} else {
+			column++
+		}
+	}
+	return line[i:]
+}
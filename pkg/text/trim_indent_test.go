@@ -0,0 +1,166 @@
+package text_test
+
+import (
+	"testing"
+
+	"github.com/shapestone/textsmith/pkg/text"
+)
+
+func TestTrimIndent_WithIndentedRawString_RemovesCommonIndentation(t *testing.T) {
+	// Given
+	input := `
+		func Example() {
+			return nil
+		}
+	`
+
+	// When
+	result := text.TrimIndent(input)
+
+	// Then
+	expected := "func Example() {\n\treturn nil\n}"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestTrimIndent_WithSpaces_KeepsRelativeIndentation(t *testing.T) {
+	// Given
+	input := "\n    database:\n      host: localhost\n        # nested\n    logging: info\n"
+
+	// When
+	result := text.TrimIndent(input)
+
+	// Then
+	expected := "database:\n  host: localhost\n    # nested\nlogging: info"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestTrimIndent_WithBlankLinesInside_IgnoresThemForIndentation(t *testing.T) {
+	// Given
+	input := "\n    line 1\n\n  \n    line 4\n"
+
+	// When
+	result := text.TrimIndent(input)
+
+	// Then
+	expected := "line 1\n\n\nline 4"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestTrimIndent_WithoutLeadingAndTrailingBlankLines_KeepsAllLines(t *testing.T) {
+	// Given
+	input := "  first\n    second"
+
+	// When
+	result := text.TrimIndent(input)
+
+	// Then
+	expected := "first\n  second"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestTrimIndent_WithOnlyOneBlankLineAtEachEnd_DropsOnlyThoseLines(t *testing.T) {
+	// Given
+	input := "\n\n  text\n\n"
+
+	// When
+	result := text.TrimIndent(input)
+
+	// Then
+	expected := "\ntext\n"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestTrimIndent_WithMixedTabsAndSpaces_MeasuresColumns(t *testing.T) {
+	// Given - a tab and four spaces are the same indentation with the default tab width
+	input := "\n\tfoo\n    bar\n\t  baz\n"
+
+	// When
+	result := text.TrimIndent(input)
+
+	// Then
+	expected := "foo\nbar\n  baz"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestTrimIndentWithOptions_WithTabCrossingTheCut_ReplacesRemainderWithSpaces(t *testing.T) {
+	// Given - two spaces are the common indentation, the tab on the second line reaches column 8
+	input := "  foo\n\tbar"
+
+	// When
+	result := text.TrimIndentWithOptions(input, text.IndentOptions{TabWidth: 8})
+
+	// Then
+	expected := "foo\n      bar"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestTrimIndent_WithUnicodeContent_PreservesContentAndUnicodeWhitespace(t *testing.T) {
+	// Given - the no-break space is content, not indentation
+	input := "\n    🚀 launch\n      世界\n     nbsp\n"
+
+	// When
+	result := text.TrimIndent(input)
+
+	// Then
+	expected := "🚀 launch\n  世界\n nbsp"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestTrimIndent_WithCRLFLines_KeepsCarriageReturns(t *testing.T) {
+	// Given
+	input := "\r\n    a\r\n      b\r\n"
+
+	// When
+	result := text.TrimIndent(input)
+
+	// Then
+	expected := "a\r\n  b\r"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestTrimIndent_WithEmptyOrBlankInput_ReturnsEmptyString(t *testing.T) {
+	// Given
+	inputs := []string{"", "\n", "   \n\t"}
+
+	for _, input := range inputs {
+		// When
+		result := text.TrimIndent(input)
+
+		// Then
+		if result != "" {
+			t.Errorf("Expected empty string for %q, got %q", input, result)
+		}
+	}
+}
+
+func TestTrimIndent_WithTabAfterCut_KeepsRelativeIndentation(t *testing.T) {
+	// Given - two spaces are the common indentation, the tab after them reaches column 4
+	input := "\n  parent\n  \tchild\n"
+
+	// When
+	result := text.TrimIndent(input)
+
+	// Then
+	expected := "parent\n  child"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
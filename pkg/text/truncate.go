@@ -0,0 +1,120 @@
+package text
+
+// DefaultEllipsis is the ellipsis Truncate puts where text was cut
+const DefaultEllipsis = "…"
+
+// TruncatePosition selects where TruncateWithOptions cuts text
+type TruncatePosition int
+
+const (
+	// TruncateEnd keeps the start of the text
+	TruncateEnd TruncatePosition = iota
+	// TruncateStart keeps the end of the text
+	TruncateStart
+	// TruncateMiddle keeps the start and the end of the text, e.g. the root and the file name of a path
+	TruncateMiddle
+)
+
+// TruncateOptions configures TruncateWithOptions
+type TruncateOptions struct {
+	// Position selects where the text is cut, TruncateEnd by default
+	Position TruncatePosition
+	// Ellipsis marks where the text was cut, DefaultEllipsis when empty
+	Ellipsis string
+	// NoEllipsis cuts the text without marking it
+	NoEllipsis bool
+}
+
+// ellipsis returns the configured ellipsis or the default
+func (o TruncateOptions) ellipsis() string {
+	if o.NoEllipsis {
+		return ""
+	}
+	if o.Ellipsis == "" {
+		return DefaultEllipsis
+	}
+	return o.Ellipsis
+}
+
+// Truncate cuts s to at most width display columns and ends it with DefaultEllipsis when it was cut. Grapheme
+// clusters, such as a letter with combining accents or an emoji sequence, are never split, so the result can be
+// a column narrower than width when a wide character does not fit.
+//
+// Code example:
+//
+//	text.Truncate("Hello, 世界!", 9) // "Hello, …"
+func Truncate(s string, width int) string {
+	return TruncateWithOptions(s, width, TruncateOptions{})
+}
+
+// TruncateWithOptions works like Truncate with a configurable cut position and ellipsis. Text that fits is
+// returned unchanged. When width is narrower than the ellipsis, the ellipsis is cut instead.
+//
+// Code example:
+//
+//	text.TruncateWithOptions("/usr/local/share/textsmith/config.yaml", 24, text.TruncateOptions{
+//		Position: text.TruncateMiddle,
+//	})
+//	// "/usr/local/s…config.yaml"
+func TruncateWithOptions(s string, width int, opts TruncateOptions) string {
+	if DisplayWidth(s) <= width {
+		return s
+	}
+	ellipsis := opts.ellipsis()
+	available := width - DisplayWidth(ellipsis)
+	if available < 0 {
+		return graphemePrefix(ellipsis, max(0, width))
+	}
+
+	switch opts.Position {
+	case TruncateStart:
+		return ellipsis + graphemeSuffix(s, available)
+	case TruncateMiddle:
+		head := graphemePrefix(s, available-available/2)
+		tail := graphemeSuffix(s[len(head):], available-DisplayWidth(head))
+		return head + ellipsis + tail
+	}
+	return graphemePrefix(s, available) + ellipsis
+}
+
+// graphemes splits s into extended grapheme clusters
+func graphemes(s string) []string {
+	var clusters []string
+	for s != "" {
+		n := graphemeLen(s)
+		clusters = append(clusters, s[:n])
+		s = s[n:]
+	}
+	return clusters
+}
+
+// graphemePrefix returns the longest run of whole grapheme clusters from the start of s that fits width
+func graphemePrefix(s string, width int) string {
+	end := 0
+	used := 0
+	for _, cluster := range graphemes(s) {
+		w := DisplayWidth(cluster)
+		if used+w > width {
+			break
+		}
+		used += w
+		end += len(cluster)
+	}
+	return s[:end]
+}
+
+// graphemeSuffix returns the longest run of whole grapheme clusters from the end of s that fits width
+func graphemeSuffix(s string, width int) string {
+	clusters := graphemes(s)
+	start := len(s)
+	used := 0
+	for i := len(clusters) - 1; i >= 0; i-- {
+		w := DisplayWidth(clusters[i])
+		if used+w > width {
+			break
+		}
+		used += w
+		start -= len(clusters[i])
+	}
+	return s[start:]
+}
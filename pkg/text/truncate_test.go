@@ -0,0 +1,112 @@
+package text_test
+
+import (
+	"testing"
+
+	"github.com/shapestone/textsmith/pkg/text"
+)
+
+func TestTruncate_WithLongText_CutsAtEndWithEllipsis(t *testing.T) {
+	// When
+	result := text.Truncate("Hello, world!", 8)
+
+	// Then
+	expected := "Hello, …"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestTruncate_WithFittingText_ReturnsTextUnchanged(t *testing.T) {
+	// When
+	result := text.Truncate("世界", 4)
+
+	// Then
+	if result != "世界" {
+		t.Fatalf("Expected %q, got %q", "世界", result)
+	}
+}
+
+func TestTruncate_WithWideCharacterAtCut_NeverSplitsIt(t *testing.T) {
+	// When
+	result := text.Truncate("Hello, 世界!", 9)
+
+	// Then
+	expected := "Hello, …"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+	if width := text.DisplayWidth(result); width > 9 {
+		t.Fatalf("Expected at most 9 columns, got %d", width)
+	}
+}
+
+func TestTruncate_WithGraphemeClusters_KeepsClustersWhole(t *testing.T) {
+	// Given
+	cases := map[string]string{
+		"cafe\u0301 au lait":                                     "cafe\u0301…",
+		"👍\U0001F3FD👍\U0001F3FD👍\U0001F3FD":                      "👍\U0001F3FD👍\U0001F3FD…",
+		"👨\u200d👩\u200d👧 family":                                 "👨\u200d👩\u200d👧 f…",
+		"🇯🇵🇫🇷🇩🇪":                                                 "🇯🇵🇫🇷…",
+		"\u1100\u1161\u11a8\u1100\u1161\u11a8\u1100\u1161\u11a8": "\u1100\u1161\u11a8\u1100\u1161\u11a8…",
+	}
+
+	for input, expected := range cases {
+		// When
+		result := text.Truncate(input, 5)
+
+		// Then
+		if result != expected {
+			t.Errorf("Expected %q for %q, got %q", expected, input, result)
+		}
+	}
+}
+
+func TestTruncateWithOptions_WithStartPosition_KeepsEnd(t *testing.T) {
+	// When
+	result := text.TruncateWithOptions("very/long/path/file.go", 12, text.TruncateOptions{Position: text.TruncateStart})
+
+	// Then
+	expected := "…ath/file.go"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestTruncateWithOptions_WithMiddlePosition_KeepsBothEnds(t *testing.T) {
+	// Given
+	opts := text.TruncateOptions{Position: text.TruncateMiddle}
+
+	// When
+	result := text.TruncateWithOptions("/usr/local/share/textsmith/config.yaml", 24, opts)
+
+	// Then
+	expected := "/usr/local/s…config.yaml"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestTruncateWithOptions_WithCustomOrNoEllipsis_UsesIt(t *testing.T) {
+	// When
+	custom := text.TruncateWithOptions("abcdefgh", 6, text.TruncateOptions{Ellipsis: "..."})
+	none := text.TruncateWithOptions("abcdefgh", 6, text.TruncateOptions{NoEllipsis: true})
+
+	// Then
+	if custom != "abc..." {
+		t.Errorf("Expected %q, got %q", "abc...", custom)
+	}
+	if none != "abcdef" {
+		t.Errorf("Expected %q, got %q", "abcdef", none)
+	}
+}
+
+func TestTruncateWithOptions_WithWidthBelowEllipsis_CutsEllipsis(t *testing.T) {
+	// When
+	result := text.TruncateWithOptions("abcdefgh", 2, text.TruncateOptions{Ellipsis: "..."})
+
+	// Then
+	if result != ".." {
+		t.Fatalf("Expected %q, got %q", "..", result)
+	}
+}
@@ -0,0 +1,51 @@
+package text_test
+
+import (
+	"testing"
+
+	"github.com/shapestone/textsmith/pkg/text"
+)
+
+func TestDisplayWidth_WithMixedScripts_CountsTerminalColumns(t *testing.T) {
+	// Given
+	cases := map[string]int{
+		"":                0,
+		"hello":           5,
+		"世界":              4,
+		"ｈｉ":              4,
+		"한국어":             6,
+		"e\u0301":         1,
+		"🌍":               2,
+		"👨\u200d👩\u200d👧": 2,
+		"🇯🇵":              2,
+		"👍\U0001F3FD":     2,
+		"\u2764\ufe0f":    2,
+		"a\tb":            2,
+		"zero\u200bwidth": 9,
+	}
+
+	for input, expected := range cases {
+		// When
+		result := text.DisplayWidth(input)
+
+		// Then
+		if result != expected {
+			t.Errorf("Expected width %d for %q, got %d", expected, input, result)
+		}
+	}
+}
+
+func TestRuneWidth_WithControlAndWideRunes_ReturnsColumns(t *testing.T) {
+	// Given
+	cases := map[rune]int{'a': 1, '\n': 0, '\u0301': 0, '中': 2, '、': 2, '！': 2, '\U0001F600': 2, 'é': 1}
+
+	for r, expected := range cases {
+		// When
+		result := text.RuneWidth(r)
+
+		// Then
+		if result != expected {
+			t.Errorf("Expected width %d for %U, got %d", expected, r, result)
+		}
+	}
+}
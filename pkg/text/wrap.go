@@ -0,0 +1,118 @@
+package text
+
+import (
+	"strings"
+)
+
+// WrapOptions configures WrapWithOptions
+type WrapOptions struct {
+	// Width is the maximum display width of a line, including its prefix. Lines are not wrapped when it is zero or
+	// negative.
+	Width int
+	// FirstPrefix is written before the first output line
+	FirstPrefix string
+	// Prefix is written before every other output line, e.g. spaces for a hanging indent
+	Prefix string
+	// TabWidth is the distance between tab stops used to measure tabs, DefaultTabWidth when zero or negative
+	TabWidth int
+}
+
+// Wrap breaks the lines of s so that none is wider than width terminal columns, at the break opportunities of the
+// Unicode Line Breaking Algorithm (UAX #14)
+//
+// Existing line breaks are kept and every input line is wrapped on its own. Whitespace is only removed where a line
+// is wrapped; leading indentation and runs of spaces between words that stay on one line are kept as written. Text
+// without a break opportunity, such as a long URL path segment, overflows rather than being split. Width is
+// measured in display columns, so East Asian wide characters and emoji count as two.
+//
+// Code example:
+//
+//	text.Wrap("The quick brown fox jumps over the lazy dog", 15)
+//	// The quick brown
+//	// fox jumps over
+//	// the lazy dog
+func Wrap(s string, width int) string {
+	return WrapWithOptions(s, WrapOptions{Width: width})
+}
+
+// WrapWithOptions works like Wrap with a first-line prefix, a prefix for all further lines and a tab width.
+// Prefixes are written before empty lines with their trailing whitespace removed.
+//
+// Code example:
+//
+//	text.WrapWithOptions("- wrapped list items line up under the text", text.WrapOptions{
+//		Width:  20,
+//		Prefix: "  ",
+//	})
+//	// - wrapped list items
+//	//   line up under the
+//	//   text
+func WrapWithOptions(s string, opts WrapOptions) string {
+	tabWidth := opts.TabWidth
+	if tabWidth <= 0 {
+		tabWidth = DefaultTabWidth
+	}
+
+	lines := splitSourceLines(s)
+	eol := "\n"
+	if lines[0].ending != "" {
+		eol = lines[0].ending
+	}
+
+	var sb strings.Builder
+	sb.Grow(len(s))
+	prefix := opts.FirstPrefix
+	for _, line := range lines {
+		for i, part := range wrapLine(line.text, opts.Width, prefix, opts.Prefix, tabWidth) {
+			if i > 0 {
+				sb.WriteString(eol)
+			}
+			sb.WriteString(part)
+		}
+		sb.WriteString(line.ending)
+		prefix = opts.Prefix
+	}
+	return sb.String()
+}
+
+// wrapLine wraps a single input line and returns the output lines including their prefixes
+func wrapLine(line string, width int, firstPrefix, prefix string, tabWidth int) []string {
+	if line == "" {
+		return []string{strings.TrimRight(firstPrefix, " \t")}
+	}
+
+	var parts []string
+	start := 0      // start of the current output line in line
+	contentEnd := 0 // end of the current output line without the spaces at its last break opportunity
+	linePrefix := firstPrefix
+	column := displayWidthAt(linePrefix, 0, tabWidth)
+
+	segmentStart := 0
+	for _, segmentEnd := range append(lineBreaks(line), len(line)) {
+		segment := line[segmentStart:segmentEnd]
+		content := strings.TrimRightFunc(segment, isWrapSpace)
+
+		contentColumn := displayWidthAt(content, column, tabWidth)
+		hasText := strings.TrimFunc(line[start:contentEnd], isWrapSpace) != ""
+		if width > 0 && contentColumn > width && hasText {
+			parts = append(parts, linePrefix+line[start:contentEnd])
+			linePrefix = prefix
+			start = segmentStart
+			contentColumn = displayWidthAt(content, displayWidthAt(linePrefix, 0, tabWidth), tabWidth)
+		}
+
+		contentEnd = segmentStart + len(content)
+		column = displayWidthAt(segment[len(content):], contentColumn, tabWidth)
+		segmentStart = segmentEnd
+	}
+	return append(parts, linePrefix+line[start:])
+}
+
+// isWrapSpace reports whether r is whitespace that is removed where a line is wrapped. No-break spaces are not.
+func isWrapSpace(r rune) bool {
+	switch r {
+	case ' ', '\t', 0x1680, 0x200B, 0x205F, 0x3000:
+		return true
+	}
+	return r >= 0x2000 && r <= 0x200A && r != 0x2007
+}
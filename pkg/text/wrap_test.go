@@ -0,0 +1,197 @@
+package text_test
+
+import (
+	"testing"
+
+	"github.com/shapestone/textsmith/pkg/text"
+)
+
+func TestWrap_WithEnglishText_BreaksBetweenWords(t *testing.T) {
+	// Given
+	input := "The quick brown fox jumps over the lazy dog"
+
+	// When
+	result := text.Wrap(input, 15)
+
+	// Then
+	expected := text.StripMargin(`
+		|The quick brown
+		|fox jumps over
+		|the lazy dog`)
+	if diff, ok := text.Diff(expected, result); !ok {
+		t.Fatalf("Unexpected wrapping:\n%s", diff)
+	}
+}
+
+func TestWrap_WithCJKText_BreaksBetweenIdeographsByDisplayWidth(t *testing.T) {
+	// Given
+	input := "日本語のテキストは単語の間にスペースがありません。"
+
+	// When
+	result := text.Wrap(input, 10)
+
+	// Then
+	expected := "日本語のテ\nキストは単\n語の間にス\nペースがあ\nりません。"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestWrap_WithClosingPunctuation_NeverStartsLineWithIt(t *testing.T) {
+	// Given
+	input := "abcd、efgh。"
+
+	// When
+	result := text.Wrap(input, 5)
+
+	// Then
+	expected := "abcd、\nefgh。"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestWrap_WithURL_BreaksOnlyAfterSlashes(t *testing.T) {
+	// Given
+	input := "see https://example.com/some/long/path for details"
+
+	// When
+	result := text.Wrap(input, 20)
+
+	// Then
+	expected := "see https://\nexample.com/some/\nlong/path for\ndetails"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestWrap_WithNumbersAndPunctuation_KeepsThemTogether(t *testing.T) {
+	// Given
+	input := "costs $100.50 (approx.) or -42% today"
+
+	// When
+	result := text.Wrap(input, 8)
+
+	// Then
+	expected := "costs\n$100.50\n(approx.)\nor -42%\ntoday"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestWrap_WithLongWord_OverflowsInsteadOfSplitting(t *testing.T) {
+	// Given
+	input := "a supercalifragilistic word"
+
+	// When
+	result := text.Wrap(input, 6)
+
+	// Then
+	expected := "a\nsupercalifragilistic\nword"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestWrap_WithWhitespaceRuns_KeepsThemExceptAtBreaks(t *testing.T) {
+	// Given
+	input := "  indented   text with  runs of spaces that wraps  "
+
+	// When
+	result := text.Wrap(input, 20)
+
+	// Then
+	expected := "  indented   text\nwith  runs of spaces\nthat wraps  "
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestWrap_WithExistingNewlines_WrapsEachLineAndKeepsEndings(t *testing.T) {
+	// Given
+	input := "first line is long\r\n\r\nsecond\r\n"
+
+	// When
+	result := text.Wrap(input, 10)
+
+	// Then
+	expected := "first line\r\nis long\r\n\r\nsecond\r\n"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestWrap_WithNonBreakingSpaceAndZeroWidthJoiner_DoesNotBreakThere(t *testing.T) {
+	// Given
+	input := "100\u00a0km 👨\u200d👩\u200d👧 ok"
+
+	// When
+	result := text.Wrap(input, 4)
+
+	// Then
+	expected := "100\u00a0km\n👨\u200d👩\u200d👧\nok"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestWrap_WithZeroWidth_DoesNotWrap(t *testing.T) {
+	// Given
+	input := "a line that is never wrapped"
+
+	// When
+	result := text.Wrap(input, 0)
+
+	// Then
+	if result != input {
+		t.Fatalf("Expected %q, got %q", input, result)
+	}
+}
+
+func TestWrapWithOptions_WithHangingIndent_IndentsContinuationLines(t *testing.T) {
+	// Given
+	input := "- wrapped list items line up under the text"
+	opts := text.WrapOptions{Width: 20, Prefix: "  "}
+
+	// When
+	result := text.WrapWithOptions(input, opts)
+
+	// Then
+	expected := text.StripMargin(`
+		|- wrapped list items
+		|  line up under the
+		|  text`)
+	if diff, ok := text.Diff(expected, result); !ok {
+		t.Fatalf("Unexpected wrapping:\n%s", diff)
+	}
+}
+
+func TestWrapWithOptions_WithQuotePrefixes_TrimsPrefixOnEmptyLines(t *testing.T) {
+	// Given
+	input := "quoted text that goes on\n\nmore"
+	opts := text.WrapOptions{Width: 12, FirstPrefix: "> ", Prefix: "> "}
+
+	// When
+	result := text.WrapWithOptions(input, opts)
+
+	// Then
+	expected := "> quoted\n> text that\n> goes on\n>\n> more"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}
+
+func TestWrapWithOptions_WithTabs_MeasuresTabStops(t *testing.T) {
+	// Given
+	input := "a\tb\tc d"
+	opts := text.WrapOptions{Width: 8, TabWidth: 4}
+
+	// When
+	result := text.WrapWithOptions(input, opts)
+
+	// Then
+	expected := "a\tb\nc d"
+	if result != expected {
+		t.Fatalf("Expected %q, got %q", expected, result)
+	}
+}